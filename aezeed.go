@@ -0,0 +1,11 @@
+package bip39
+
+import "github.com/islishude/bip39/internal/cipherseed"
+
+// isAezeed reports whether the mnemonic is an LND aezeed cipher seed: 24
+// English words whose 33 bytes start with the version 0 and end with the
+// CRC32-C of the rest. See the aezeed package to decipher it.
+func isAezeed(mnemonic string) bool {
+	_, err := cipherseed.Decode(mnemonic)
+	return err == nil
+}
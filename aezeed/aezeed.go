@@ -0,0 +1,172 @@
+// Package aezeed implements the LND aezeed cipher seed.
+//
+// An aezeed is 24 words of the English BIP39 word list encoding 33 bytes: the
+// external version, the AEZ encrypted internal version, wallet birthday and
+// 16 bytes entropy, the scrypt salt and a CRC32-C checksum. Unlike BIP39 the
+// passphrase is authenticated, a wrong one is rejected instead of deriving a
+// different wallet.
+package aezeed
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/Yawning/aez"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/islishude/bip39/internal/cipherseed"
+	"github.com/islishude/bip39/internal/wordlist"
+)
+
+// Version is the external version of the cipher seed
+const Version = cipherseed.Version
+
+// sizes of the enciphered seed
+const (
+	// EntropySize is the length of the seed entropy
+	EntropySize = 16
+	// WordCount is the number of mnemonic words
+	WordCount = cipherseed.WordCount
+
+	plainSize    = 19
+	cipherSize   = cipherseed.Size
+	saltSize     = 5
+	checksumSize = 4
+	expansion    = 4
+	saltOffset   = cipherSize - checksumSize - saltSize
+	sumOffset    = cipherseed.SumOffset
+)
+
+// scrypt parameters
+var (
+	scryptN = 32768
+	scryptR = 8
+	scryptP = 1
+)
+
+// defaultPassphrase is used when the passphrase is empty
+const defaultPassphrase = "aezeed"
+
+// GenesisDate is the zero birthday, the Bitcoin genesis block time
+var GenesisDate = time.Unix(1231006505, 0)
+
+// Error list
+var (
+	ErrEntropyLen        = errors.New("aezeed: entropy must be 16 bytes")
+	ErrWordCount         = cipherseed.ErrWordCount
+	ErrUnknownWord       = cipherseed.ErrUnknownWord
+	ErrIncorrectVersion  = cipherseed.ErrIncorrectVersion
+	ErrChecksum          = cipherseed.ErrChecksum
+	ErrInvalidPassphrase = errors.New("aezeed: invalid passphrase")
+	ErrBirthday          = errors.New("aezeed: birthday out of range")
+)
+
+// Seed is a deciphered aezeed
+type Seed struct {
+	InternalVersion uint8
+	Birthday        uint16 // days since GenesisDate
+	Entropy         [EntropySize]byte
+	Salt            [saltSize]byte
+}
+
+// New returns a seed born at birthday, the entropy and the salt are read
+// from r if entropy is nil. The crypto/rand reader is used if r is nil.
+// The birthday must be within 65535 days from GenesisDate.
+func New(entropy []byte, birthday time.Time, r io.Reader) (*Seed, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	days := birthday.Sub(GenesisDate) / (24 * time.Hour)
+	if birthday.Before(GenesisDate) || days > math.MaxUint16 {
+		return nil, ErrBirthday
+	}
+	s := &Seed{Birthday: uint16(days)}
+	if entropy == nil {
+		if _, err := io.ReadFull(r, s.Entropy[:]); err != nil {
+			return nil, err
+		}
+	} else if len(entropy) != EntropySize {
+		return nil, ErrEntropyLen
+	} else {
+		copy(s.Entropy[:], entropy)
+	}
+	if _, err := io.ReadFull(r, s.Salt[:]); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// BirthdayTime returns the birthday as a time
+func (s *Seed) BirthdayTime() time.Time {
+	return GenesisDate.Add(time.Duration(s.Birthday) * 24 * time.Hour)
+}
+
+func key(passphrase string, salt []byte) ([]byte, error) {
+	if passphrase == "" {
+		passphrase = defaultPassphrase
+	}
+	return scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, 32)
+}
+
+// Mnemonic enciphers the seed with the passphrase and returns its words
+func (s *Seed) Mnemonic(passphrase string) (string, error) {
+	k, err := key(passphrase, s.Salt[:])
+	if err != nil {
+		return "", err
+	}
+	plain := make([]byte, 0, plainSize)
+	plain = append(plain, s.InternalVersion)
+	plain = binary.BigEndian.AppendUint16(plain, s.Birthday)
+	plain = append(plain, s.Entropy[:]...)
+
+	ad := append([]byte{Version}, s.Salt[:]...)
+	data := make([]byte, 0, cipherSize)
+	data = append(data, Version)
+	data = append(data, aez.Encrypt(k, nil, [][]byte{ad}, expansion, plain, nil)...)
+	data = append(data, s.Salt[:]...)
+	data = binary.BigEndian.AppendUint32(data, crc32.Checksum(data, cipherseed.Castagnoli))
+
+	n := new(big.Int).SetBytes(data)
+	words := make([]string, WordCount)
+	for i := WordCount - 1; i >= 0; i-- {
+		words[i] = wordlist.English[new(big.Int).And(n, big.NewInt(2047)).Int64()]
+		n.Rsh(n, 11)
+	}
+	return strings.Join(words, "\x20"), nil
+}
+
+// Decode deciphers the mnemonic with the passphrase
+func Decode(mnemonic, passphrase string) (*Seed, error) {
+	data, err := cipherseed.Decode(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	k, err := key(passphrase, data[saltOffset:sumOffset])
+	if err != nil {
+		return nil, err
+	}
+	ad := append([]byte{data[0]}, data[saltOffset:sumOffset]...)
+	plain, ok := aez.Decrypt(k, nil, [][]byte{ad}, expansion, data[1:saltOffset], nil)
+	if !ok || len(plain) != plainSize {
+		return nil, ErrInvalidPassphrase
+	}
+
+	s := &Seed{InternalVersion: plain[0], Birthday: binary.BigEndian.Uint16(plain[1:])}
+	copy(s.Entropy[:], plain[3:])
+	copy(s.Salt[:], data[saltOffset:])
+	return s, nil
+}
+
+// IsAezeed reports whether the mnemonic has the aezeed version and checksum,
+// it does not check the passphrase
+func IsAezeed(mnemonic string) bool {
+	_, err := cipherseed.Decode(mnemonic)
+	return err == nil
+}
@@ -0,0 +1,115 @@
+package aezeed
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+)
+
+func init() {
+	// the LND test vectors are generated with a cheap scrypt
+	scryptN = 16
+}
+
+// LND test vectors
+func TestMnemonic(t *testing.T) {
+	entropy, _ := hex.DecodeString("81b637d86359e6960de795e41e0b4cfd")
+	tests := []struct {
+		name       string
+		birthday   time.Time
+		passphrase string
+		want       string
+		days       uint16
+	}{
+		{
+			name:     "no passphrase",
+			birthday: GenesisDate,
+			want:     "ability liquid travel stem barely drastic pact cupboard apple thrive morning oak feature tissue couch old math inform success suggest drink motion know royal",
+			days:     0,
+		},
+		{
+			name:       "passphrase",
+			birthday:   time.Unix(1521799345, 0),
+			passphrase: "!very_safe_55345_password*",
+			want:       "able tree stool crush transfer cloud cross three profit outside hen citizen plate ride require leg siren drum success suggest drink require fiscal upgrade",
+			days:       3365,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed, err := New(entropy, tt.birthday, strings.NewReader("salt1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seed.Birthday != tt.days {
+				t.Errorf("Birthday = %d, want %d", seed.Birthday, tt.days)
+			}
+			got, err := seed.Mnemonic(tt.passphrase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("Mnemonic() = %s, want %s", got, tt.want)
+			}
+
+			decoded, err := Decode(got, tt.passphrase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *decoded != *seed {
+				t.Errorf("Decode() = %+v, want %+v", decoded, seed)
+			}
+			if !decoded.BirthdayTime().Equal(GenesisDate.AddDate(0, 0, int(tt.days))) {
+				t.Errorf("BirthdayTime() = %v", decoded.BirthdayTime())
+			}
+		})
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	valid := "ability liquid travel stem barely drastic pact cupboard apple thrive morning oak feature tissue couch old math inform success suggest drink motion know royal"
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase string
+		want       error
+	}{
+		{"wrong passphrase", valid, "wrong", ErrInvalidPassphrase},
+		{"checksum", strings.Replace(valid, "royal", "roast", 1), "", ErrChecksum},
+		{"version", "zoo " + strings.SplitN(valid, " ", 2)[1], "", ErrIncorrectVersion},
+		{"word count", "ability liquid travel", "", ErrWordCount},
+		{"unknown word", strings.Replace(valid, "royal", "royale", 1), "", ErrUnknownWord},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.mnemonic, tt.passphrase); err != tt.want {
+				t.Errorf("Decode() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+	if !IsAezeed(valid) || IsAezeed(strings.Replace(valid, "royal", "roast", 1)) {
+		t.Error("IsAezeed() mismatch")
+	}
+}
+
+func TestNew(t *testing.T) {
+	rand := bytes.NewReader(bytes.Repeat([]byte{7}, EntropySize+saltSize))
+	seed, err := New(nil, GenesisDate.AddDate(0, 0, 10), rand)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seed.Entropy != [EntropySize]byte(bytes.Repeat([]byte{7}, EntropySize)) || seed.Birthday != 10 {
+		t.Errorf("New() = %+v", seed)
+	}
+	if _, err := New(make([]byte, 32), GenesisDate, rand); err == nil {
+		t.Error("New() should reject 32 bytes entropy")
+	}
+	entropy := make([]byte, EntropySize)
+	for _, birthday := range []time.Time{GenesisDate.Add(-time.Hour), GenesisDate.AddDate(0, 0, 65536)} {
+		if _, err := New(entropy, birthday, rand); err != ErrBirthday {
+			t.Errorf("New(%v) error = %v, want %v", birthday, err, ErrBirthday)
+		}
+	}
+}
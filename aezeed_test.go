@@ -0,0 +1,43 @@
+package bip39
+
+import "testing"
+
+func TestCheckMnemonicAezeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		lang     Language
+		want     error
+	}{
+		{
+			name:     "aezeed",
+			mnemonic: "ability liquid travel stem barely drastic pact cupboard apple thrive morning oak feature tissue couch old math inform success suggest drink motion know royal",
+			lang:     English,
+			want:     ErrAezeed,
+		},
+		{
+			name:     "aezeed with passphrase",
+			mnemonic: "able tree stool crush transfer cloud cross three profit outside hen citizen plate ride require leg siren drum success suggest drink require fiscal upgrade",
+			lang:     English,
+			want:     ErrAezeed,
+		},
+		{
+			name:     "broken aezeed checksum",
+			mnemonic: "ability liquid travel stem barely drastic pact cupboard apple thrive morning oak feature tissue couch old math inform success suggest drink motion know roast",
+			lang:     English,
+			want:     ErrChecksumIncorrect,
+		},
+		{
+			name:     "bip39",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			lang:     English,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckMnemonic(tt.mnemonic, tt.lang); err != tt.want {
+				t.Errorf("CheckMnemonic() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
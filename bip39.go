@@ -10,9 +10,6 @@ import (
 	"golang.org/x/text/unicode/norm"
 )
 
-// cryptoRander is a test stub for NewMnemonic func
-var cryptoRander = rand.Reader
-
 // NewMnemonicByEntropy create new mnemonic by entropy provided
 func NewMnemonicByEntropy(entropy []byte, lang Language) (string, error) {
 	entLen := len(entropy)
@@ -24,7 +21,21 @@ func NewMnemonicByEntropy(entropy []byte, lang Language) (string, error) {
 }
 
 // NewMnemonic creates new mnemonic by words length for language provided
+// with the crypto/rand reader
 func NewMnemonic(length int, lang Language) (string, error) {
+	return NewMnemonicWithOptions(length, lang, MnemonicOptions{})
+}
+
+// MnemonicOptions are the NewMnemonicWithOptions options
+type MnemonicOptions struct {
+	// Rand is the entropy source, the crypto/rand reader is used if it's nil.
+	// A Mixer combines several sources, a DRBG makes reproducible mnemonics.
+	Rand io.Reader
+}
+
+// NewMnemonicWithOptions creates new mnemonic by words length for language
+// provided with the entropy of the options source
+func NewMnemonicWithOptions(length int, lang Language, opts MnemonicOptions) (string, error) {
 	// word length should be 12 | 15 | 18 | 21 | 24
 	if length < 12 || length > 24 || length%3 != 0 {
 		return "", ErrWordLen
@@ -43,13 +54,21 @@ func NewMnemonic(length int, lang Language) (string, error) {
 		|  256  |  8 |   264  |  24  |
 	*/
 	entropy := make([]byte, length+length/3)
-	if _, err := io.ReadFull(cryptoRander, entropy); err != nil {
+	if _, err := io.ReadFull(randReader(opts.Rand), entropy); err != nil {
 		return "", err
 	}
 
 	return fromEntropy(entropy, length, lang), nil
 }
 
+// randReader returns r, or the crypto/rand reader if it's nil
+func randReader(r io.Reader) io.Reader {
+	if r == nil {
+		return rand.Reader
+	}
+	return r
+}
+
 // MnemonicToSeed creates 64 bytes seed by pbkdf
 // passphrace is optional,it can be empty string
 func MnemonicToSeed(mnemonic, passphrase string) []byte {
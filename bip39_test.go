@@ -5,10 +5,26 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"io"
+	"strings"
 	"testing"
 )
 
 func TestNewMnemonic(t *testing.T) {
+	for _, length := range []int{12, 15, 18, 21, 24} {
+		got, err := NewMnemonic(length, English)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsMnemonicValid(got, English) || len(strings.Fields(got)) != length {
+			t.Errorf("NewMnemonic() = %v is not a valid %d words mnemonic", got, length)
+		}
+	}
+	if _, err := NewMnemonic(13, English); err != ErrWordLen {
+		t.Errorf("NewMnemonic() error = %v, want %v", err, ErrWordLen)
+	}
+}
+
+func TestNewMnemonicWithOptions(t *testing.T) {
 	type args struct {
 		wordsLen int
 		lang     Language
@@ -63,14 +79,13 @@ func TestNewMnemonic(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cryptoRander = tt.rander
-			got, err := NewMnemonic(tt.args.wordsLen, tt.args.lang)
+			got, err := NewMnemonicWithOptions(tt.args.wordsLen, tt.args.lang, MnemonicOptions{Rand: tt.rander})
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NewMnemonic() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NewMnemonicWithOptions() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if got != tt.want {
-				t.Errorf("NewMnemonic() = %v, want %v", got, tt.want)
+				t.Errorf("NewMnemonicWithOptions() = %v, want %v", got, tt.want)
 			}
 		})
 	}
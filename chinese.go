@@ -0,0 +1,171 @@
+package bip39
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/islishude/bip39/internal/wordlist"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	pinyinOnce    sync.Once
+	pinyinMapping map[string][]int64
+)
+
+// chinese reports whether the language is one of the index aligned Chinese
+// lists, it returns the other one too
+func (lan Language) chinese() (Language, bool) {
+	switch lan {
+	case ChineseSimplified:
+		return ChineseTraditional, true
+	case ChineseTraditional:
+		return ChineseSimplified, true
+	}
+	return lan, false
+}
+
+// splitHan splits the words made of several Han characters, every word of
+// the Chinese lists is a single character
+func splitHan(words []string) []string {
+	res := make([]string, 0, len(words))
+	for _, w := range words {
+		if !strings.ContainsFunc(w, func(r rune) bool { return !unicode.Is(unicode.Han, r) }) {
+			for _, r := range w {
+				res = append(res, string(r))
+			}
+			continue
+		}
+		res = append(res, w)
+	}
+	return res
+}
+
+// chineseScriptError returns ErrChineseScript if all the words are in one
+// of the Chinese lists but some of them are not in the lang list
+func chineseScriptError(words []string, lang Language) error {
+	other, ok := lang.chinese()
+	if !ok {
+		return nil
+	}
+	own, foreign := lang.mapping(), other.mapping()
+	misplaced := false
+	for _, w := range words {
+		if _, ok := own[w]; ok {
+			continue
+		}
+		if _, ok := foreign[w]; !ok {
+			return nil
+		}
+		misplaced = true
+	}
+	if misplaced {
+		return ErrChineseScript
+	}
+	return nil
+}
+
+// ChineseScript returns the Chinese list of a mnemonic, which may be written
+// without separators. ErrChineseScript is returned for a mnemonic mixing the
+// characters of both lists, the words have the same indexes in both but the
+// seed of the sentence depends on the script.
+func ChineseScript(mnemonic string) (Language, error) {
+	words := splitHan(splitWords(mnemonic))
+	if err := chineseScriptError(words, ChineseSimplified); err == nil {
+		_, err := MnemonicToEntropy(strings.Join(words, "\x20"), ChineseSimplified)
+		return ChineseSimplified, err
+	}
+	if err := chineseScriptError(words, ChineseTraditional); err != nil {
+		return ChineseSimplified, err
+	}
+	_, err := MnemonicToEntropy(strings.Join(words, "\x20"), ChineseTraditional)
+	return ChineseTraditional, err
+}
+
+// PinyinCandidates returns the characters of the Chinese list matching every
+// word of a mnemonic typed in pinyin, tones are ignored and may be written
+// with marks or numbers, such as "shì" or "shi4", ü may be typed v. Words
+// typed as characters of the list are their own candidate.
+// The candidates of the only ambiguous word, if any, are narrowed down to
+// the ones passing the checksum.
+func PinyinCandidates(input string, lang Language) ([][]string, error) {
+	if _, ok := lang.chinese(); !ok {
+		return nil, fmt.Errorf("%v is not a chinese word list", lang)
+	}
+	pinyinOnce.Do(func() {
+		pinyinMapping = make(map[string][]int64)
+		for idx, readings := range wordlist.Pinyin {
+			for _, r := range strings.Fields(readings) {
+				pinyinMapping[r] = append(pinyinMapping[r], int64(idx))
+			}
+		}
+	})
+
+	syllables := strings.FieldsFunc(strings.Join(splitWords(input), "\x20"), func(r rune) bool {
+		return r == '\x20' || r == '\''
+	})
+	if n := len(syllables); n%3 != 0 || n < 12 || n > 24 {
+		return nil, ErrWordLen
+	}
+	list := lang.list()
+	candidates := make([][]string, len(syllables))
+	ambiguous := -1
+	mapping := lang.mapping()
+	for i, s := range syllables {
+		indexes := pinyinMapping[toneless(s)]
+		if idx, ok := mapping[s]; ok {
+			indexes = []int64{idx}
+		}
+		if len(indexes) == 0 {
+			return nil, fmt.Errorf("pinyin `%s` at `%d` not found in mnemonic mapping", s, i)
+		}
+		for _, idx := range indexes {
+			candidates[i] = append(candidates[i], list[idx])
+		}
+		if len(indexes) > 1 {
+			ambiguous = i
+		}
+	}
+
+	if ambiguous >= 0 && countAmbiguous(candidates) == 1 {
+		words := make([]string, len(candidates))
+		for i, c := range candidates {
+			words[i] = c[0]
+		}
+		var valid []string
+		for _, c := range candidates[ambiguous] {
+			words[ambiguous] = c
+			if _, err := MnemonicToEntropy(strings.Join(words, "\x20"), lang); err == nil {
+				valid = append(valid, c)
+			}
+		}
+		if len(valid) == 0 {
+			return nil, ErrChecksumIncorrect
+		}
+		candidates[ambiguous] = valid
+	}
+	return candidates, nil
+}
+
+func countAmbiguous(candidates [][]string) (n int) {
+	for _, c := range candidates {
+		if len(c) > 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// toneless returns the pinyin without its tone mark or number, ü is v
+func toneless(s string) string {
+	s = strings.NewReplacer("ü", "v", "u:", "v").Replace(norm.NFC.String(s))
+	s = strings.TrimRight(s, "012345")
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(s))
+}
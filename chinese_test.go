@@ -0,0 +1,141 @@
+package bip39
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip39/internal/wordlist"
+)
+
+const (
+	simplifiedMnemonic  = "持 楼 粗 杀 承 图 涌 整 拿 路 式 棋"
+	traditionalMnemonic = "持 樓 粗 殺 承 圖 湧 整 拿 路 式 棋"
+)
+
+func TestParseMnemonicChinese(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		lang    Language
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "no separator",
+			input: "持楼粗杀承图涌整拿路式棋",
+			lang:  ChineseSimplified,
+			want:  simplifiedMnemonic,
+		},
+		{
+			name:  "chinese punctuation",
+			input: "持楼粗杀，承图涌整、拿路式棋",
+			lang:  ChineseSimplified,
+			want:  simplifiedMnemonic,
+		},
+		{
+			name:  "traditional",
+			input: "持樓粗殺承圖湧整拿路式棋",
+			lang:  ChineseTraditional,
+			want:  traditionalMnemonic,
+		},
+		{
+			name:    "traditional as simplified",
+			input:   traditionalMnemonic,
+			lang:    ChineseSimplified,
+			wantErr: ErrChineseScript,
+		},
+		{
+			name:    "simplified as traditional",
+			input:   "持楼粗杀承图涌整拿路式棋",
+			lang:    ChineseTraditional,
+			wantErr: ErrChineseScript,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMnemonic(tt.input, tt.lang, ParseOptions{})
+			if err != tt.wantErr {
+				t.Fatalf("ParseMnemonic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMnemonic() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Language
+		wantErr error
+	}{
+		{name: "simplified", input: simplifiedMnemonic, want: ChineseSimplified},
+		{name: "traditional", input: "持樓粗殺承圖湧整拿路式棋", want: ChineseTraditional},
+		{name: "mixed", input: "持 樓 粗 杀 承 图 涌 整 拿 路 式 棋", wantErr: ErrChineseScript},
+		{name: "checksum", input: "持 樓 粗 殺 承 圖 湧 整 拿 路 式 持", want: ChineseTraditional, wantErr: ErrChecksumIncorrect},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChineseScript(tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("ChineseScript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ChineseScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPinyinWordlist(t *testing.T) {
+	if len(wordlist.Pinyin) != len(wordlist.ChineseSimplified) {
+		t.Fatalf("%d pinyin for %d words", len(wordlist.Pinyin), len(wordlist.ChineseSimplified))
+	}
+	for idx, readings := range wordlist.Pinyin {
+		if readings == "" || strings.Trim(readings, "abcdefghijklmnopqrstuvwxyz ") != "" {
+			t.Errorf("invalid pinyin %q of %s", readings, wordlist.ChineseSimplified[idx])
+		}
+	}
+}
+
+func TestPinyinCandidates(t *testing.T) {
+	got, err := PinyinCandidates("chí lou2 cu sha cheng tu yong zheng na lu shi qi", ChineseSimplified)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 12 || !reflect.DeepEqual(got[1], []string{"露", "楼", "漏"}) || len(got[10]) < 10 {
+		t.Errorf("PinyinCandidates() = %v", got)
+	}
+
+	// the checksum picks the last word
+	got, err = PinyinCandidates("持 樓 粗 殺 承 圖 湧 整 拿 路 式 qi", ChineseTraditional)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"棋"}; !reflect.DeepEqual(got[11], want) {
+		t.Errorf("PinyinCandidates() last word = %v, want %v", got[11], want)
+	}
+
+	got, err = PinyinCandidates("持 楼 粗 杀 承 图 涌 整 拿 lü 式 棋", ChineseSimplified)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range got[9] {
+		if w == "路" {
+			t.Errorf("PinyinCandidates() lü = %v", got[9])
+		}
+	}
+
+	if _, err := PinyinCandidates("chi lou cu sha cheng tu yong zheng na lu shi qx", ChineseSimplified); err == nil {
+		t.Error("PinyinCandidates() accepts an unknown syllable")
+	}
+	if _, err := PinyinCandidates("chi lou cu", ChineseSimplified); err != ErrWordLen {
+		t.Errorf("PinyinCandidates() error = %v, want %v", err, ErrWordLen)
+	}
+	if _, err := PinyinCandidates("chi lou cu sha cheng tu yong zheng na lu shi qi", English); err == nil {
+		t.Error("PinyinCandidates() accepts English")
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/islishude/bip39"
+)
+
+var commands = map[string]func(e *env, args []string) error{
+	"generate":        generate,
+	"validate":        validate,
+	"entropy":         entropy,
+	"from-entropy":    fromEntropy,
+	"seed":            seed,
+	"detect-language": detectLanguage,
+}
+
+// options shared by every command
+type options struct {
+	flags        *flag.FlagSet
+	lang         string
+	json         bool
+	insecureArgs bool
+}
+
+func newOptions(e *env, name string, lang, secret bool) *options {
+	o := &options{flags: flag.NewFlagSet(name, flag.ContinueOnError)}
+	o.flags.SetOutput(e.stderr)
+	o.flags.BoolVar(&o.json, "json", false, "print JSON output")
+	if lang {
+		o.flags.StringVar(&o.lang, "lang", "english", "wordlist language, a name or a BCP 47 tag")
+	}
+	if secret {
+		o.flags.BoolVar(&o.insecureArgs, "insecure-args", false, "allow secrets as arguments")
+	}
+	return o
+}
+
+func (o *options) language() (bip39.Language, error) {
+	return bip39.ParseLanguage(o.lang)
+}
+
+// print writes v as JSON or text as a line
+func (o *options) print(e *env, v any, text string) error {
+	if o.json {
+		enc := json.NewEncoder(e.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	_, err := fmt.Fprintln(e.stdout, text)
+	return err
+}
+
+func generate(e *env, args []string) error {
+	o := newOptions(e, "generate", true, false)
+	words := o.flags.Int("words", 24, "number of words: 12, 15, 18, 21 or 24")
+	if err := o.flags.Parse(args); err != nil {
+		return err
+	}
+	lang, err := o.language()
+	if err != nil {
+		return err
+	}
+	mnemonic, err := bip39.NewMnemonic(*words, lang)
+	if err != nil {
+		return err
+	}
+	return o.print(e, map[string]any{
+		"mnemonic": mnemonic,
+		"language": lang.String(),
+		"words":    *words,
+	}, mnemonic)
+}
+
+func validate(e *env, args []string) error {
+	o := newOptions(e, "validate", true, true)
+	if err := o.flags.Parse(args); err != nil {
+		return err
+	}
+	lang, err := o.language()
+	if err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("mnemonic", o.flags.Args(), o.insecureArgs)
+	if err != nil {
+		return err
+	}
+
+	result := map[string]any{"valid": true, "language": lang.String()}
+	text := "valid"
+	checkErr := bip39.CheckMnemonic(strings.TrimSpace(mnemonic), lang)
+	if checkErr != nil {
+		result["valid"], result["error"] = false, checkErr.Error()
+		text = "invalid: " + checkErr.Error()
+	}
+	if err := o.print(e, result, text); err != nil {
+		return err
+	}
+	if checkErr != nil {
+		return errInvalid
+	}
+	return nil
+}
+
+func entropy(e *env, args []string) error {
+	o := newOptions(e, "entropy", true, true)
+	if err := o.flags.Parse(args); err != nil {
+		return err
+	}
+	lang, err := o.language()
+	if err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("mnemonic", o.flags.Args(), o.insecureArgs)
+	if err != nil {
+		return err
+	}
+	ent, err := bip39.MnemonicToEntropy(strings.TrimSpace(mnemonic), lang)
+	if err != nil {
+		return err
+	}
+	text := hex.EncodeToString(ent)
+	return o.print(e, map[string]any{"entropy": text, "bits": len(ent) * 8}, text)
+}
+
+func fromEntropy(e *env, args []string) error {
+	o := newOptions(e, "from-entropy", true, true)
+	if err := o.flags.Parse(args); err != nil {
+		return err
+	}
+	lang, err := o.language()
+	if err != nil {
+		return err
+	}
+	input, err := e.readSecret("entropy (hex)", o.flags.Args(), o.insecureArgs)
+	if err != nil {
+		return err
+	}
+	ent, err := hex.DecodeString(strings.TrimSpace(input))
+	if err != nil {
+		return fmt.Errorf("invalid hex entropy: %w", err)
+	}
+	mnemonic, err := bip39.NewMnemonicByEntropy(ent, lang)
+	if err != nil {
+		return err
+	}
+	return o.print(e, map[string]any{"mnemonic": mnemonic, "language": lang.String()}, mnemonic)
+}
+
+func seed(e *env, args []string) error {
+	o := newOptions(e, "seed", true, true)
+	withPassphrase := o.flags.Bool("passphrase", false, "read a BIP39 passphrase after the mnemonic")
+	if err := o.flags.Parse(args); err != nil {
+		return err
+	}
+	lang, err := o.language()
+	if err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("mnemonic", o.flags.Args(), o.insecureArgs)
+	if err != nil {
+		return err
+	}
+	mnemonic = strings.TrimSpace(mnemonic)
+	if err := bip39.CheckMnemonic(mnemonic, lang); err != nil {
+		return err
+	}
+	var passphrase string
+	if *withPassphrase {
+		if passphrase, err = e.readSecret("passphrase", nil, false); err != nil {
+			return err
+		}
+	}
+	text := hex.EncodeToString(bip39.MnemonicToSeed(mnemonic, passphrase))
+	return o.print(e, map[string]any{"seed": text}, text)
+}
+
+func detectLanguage(e *env, args []string) error {
+	o := newOptions(e, "detect-language", false, true)
+	if err := o.flags.Parse(args); err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("mnemonic", o.flags.Args(), o.insecureArgs)
+	if err != nil {
+		return err
+	}
+	mnemonic = strings.TrimSpace(mnemonic)
+
+	var found []string
+	for _, lang := range bip39.Languages() {
+		if bip39.IsMnemonicValid(mnemonic, lang) {
+			found = append(found, lang.String())
+		}
+	}
+	if len(found) == 0 {
+		if err := o.print(e, map[string]any{"languages": []string{}}, "unknown"); err != nil {
+			return err
+		}
+		return errInvalid
+	}
+	return o.print(e, map[string]any{"languages": found}, strings.Join(found, "\n"))
+}
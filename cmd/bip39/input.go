@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errSecretArgs = errors.New("refusing to read secrets from arguments, they leak through the shell history; use -insecure-args to override")
+
+// readSecret returns the secret from arguments if allowed, from the terminal without echo,
+// or the next line of standard input. Spaces are kept since they are significant in a passphrase.
+func (e *env) readSecret(prompt string, args []string, insecureArgs bool) (string, error) {
+	if len(args) > 0 {
+		if !insecureArgs {
+			return "", errSecretArgs
+		}
+		return strings.Join(args, " "), nil
+	}
+
+	if e.readPassword != nil {
+		fmt.Fprint(e.stderr, prompt+": ")
+		line, err := e.readPassword()
+		fmt.Fprintln(e.stderr)
+		if err != nil {
+			return "", err
+		}
+		return string(line), nil
+	}
+
+	if e.lines == nil {
+		e.lines = bufio.NewScanner(e.stdin)
+	}
+	if !e.lines.Scan() {
+		if err := e.lines.Err(); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("missing %s on standard input", prompt)
+	}
+	return strings.TrimSuffix(e.lines.Text(), "\r"), nil
+}
@@ -0,0 +1,93 @@
+// Command bip39 generates, validates and converts BIP39 mnemonics.
+//
+// Usage:
+//
+//	bip39 <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	generate         generate a new mnemonic
+//	validate         check the words and checksum of a mnemonic
+//	entropy          print the entropy of a mnemonic in hex
+//	from-entropy     create a mnemonic from hex entropy
+//	seed             print the 64 bytes seed of a mnemonic and passphrase in hex
+//	detect-language  print the languages a mnemonic is valid in
+//
+// Secrets are read from the terminal without echo, or from standard input when
+// it is not a terminal. Passing a mnemonic, entropy or passphrase as an argument
+// would leak it through the shell history, so it is refused unless -insecure-args is set.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+const usage = `usage: bip39 <command> [flags] [arguments]
+
+commands:
+  generate         generate a new mnemonic
+  validate         check the words and checksum of a mnemonic
+  entropy          print the entropy of a mnemonic in hex
+  from-entropy     create a mnemonic from hex entropy
+  seed             print the 64 bytes seed of a mnemonic and passphrase in hex
+  detect-language  print the languages a mnemonic is valid in
+
+run "bip39 <command> -h" for the flags of a command
+`
+
+// errInvalid makes the process exit with status 1 without printing usage
+var errInvalid = errors.New("invalid")
+
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	// readPassword reads a line from the terminal without echo, it is nil if stdin isn't a terminal
+	readPassword func() ([]byte, error)
+
+	lines *bufio.Scanner
+}
+
+func main() {
+	e := &env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+		e.readPassword = func() ([]byte, error) { return term.ReadPassword(fd) }
+	}
+
+	err := run(e, os.Args[1:])
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		// the usage is printed on request
+	case errors.Is(err, errInvalid):
+		os.Exit(1)
+	default:
+		fmt.Fprintln(os.Stderr, "bip39:", err)
+		os.Exit(2)
+	}
+}
+
+func run(e *env, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(e.stderr, usage)
+		return errors.New("missing command")
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(e.stdout, usage)
+		return flag.ErrHelp
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprint(e.stderr, usage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return cmd(e, args[1:])
+}
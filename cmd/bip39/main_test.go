@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+)
+
+const abandonMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "entropy",
+			args:  []string{"entropy"},
+			stdin: abandonMnemonic + "\n",
+			want:  "00000000000000000000000000000000\n",
+		},
+		{
+			name:  "from-entropy json",
+			args:  []string{"from-entropy", "-json"},
+			stdin: "00000000000000000000000000000000",
+			want:  "{\n  \"language\": \"English\",\n  \"mnemonic\": \"" + abandonMnemonic + "\"\n}\n",
+		},
+		{
+			name:  "seed with passphrase",
+			args:  []string{"seed", "-passphrase"},
+			stdin: abandonMnemonic + "\nTREZOR\n",
+			want:  "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04\n",
+		},
+		{
+			name: "validate from arguments",
+			args: append([]string{"validate", "-insecure-args"}, strings.Fields(abandonMnemonic)...),
+			want: "valid\n",
+		},
+		{
+			name:    "invalid mnemonic",
+			args:    []string{"validate"},
+			stdin:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon\n",
+			want:    "invalid: checksum incorrect\n",
+			wantErr: errInvalid,
+		},
+		{
+			name:  "detect language",
+			args:  []string{"detect-language"},
+			stdin: "posible ruptura ozono ligero bobina acto chuleta tetera gol realidad pez alerta\n",
+			want:  "Spanish\n",
+		},
+		{
+			name:    "help",
+			args:    []string{"-h"},
+			want:    usage,
+			wantErr: flag.ErrHelp,
+		},
+		{
+			name:    "command help",
+			args:    []string{"generate", "-h"},
+			wantErr: flag.ErrHelp,
+		},
+		{
+			name:    "secret arguments",
+			args:    []string{"entropy", "abandon"},
+			wantErr: errSecretArgs,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			e := &env{stdin: strings.NewReader(tt.stdin), stdout: &stdout, stderr: &stderr}
+			if err := run(e, tt.args); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("run() output = %q, want %q", stdout.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Terminal(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	e := &env{
+		stdout:       &stdout,
+		stderr:       &stderr,
+		readPassword: func() ([]byte, error) { return []byte(abandonMnemonic), nil },
+	}
+	if err := run(e, []string{"validate", "-json"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(stdout.String(), `"valid": true`) {
+		t.Errorf("run() output = %q", stdout.String())
+	}
+	if strings.Contains(stderr.String(), "abandon") {
+		t.Errorf("secret is echoed: %q", stderr.String())
+	}
+}
+
+func TestRun_Generate(t *testing.T) {
+	var stdout bytes.Buffer
+	e := &env{stdout: &stdout, stderr: &bytes.Buffer{}}
+	if err := run(e, []string{"generate", "-words", "15", "-lang", "french"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(strings.Fields(stdout.String())); n != 15 {
+		t.Errorf("generate printed %d words, want 15", n)
+	}
+	if err := run(e, []string{"generate", "-lang", "klingon"}); err == nil {
+		t.Error("generate with unknown language succeeded")
+	}
+	if err := run(e, []string{"unknown"}); err == nil {
+		t.Error("unknown command succeeded")
+	}
+}
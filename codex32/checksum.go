@@ -0,0 +1,96 @@
+package codex32
+
+// uint128 holds the BCH checksum residues, 65 bits for the short checksum
+// and 75 bits for the long one
+type uint128 struct {
+	hi, lo uint64
+}
+
+func (x uint128) xor(y uint128) uint128 {
+	return uint128{x.hi ^ y.hi, x.lo ^ y.lo}
+}
+
+// bch is a BCH code over GF(32) generating checksums of length chars
+type bch struct {
+	length int
+	init   uint128
+	gen    [5]uint128
+	target uint128
+}
+
+var (
+	// ms32 is the 13 characters checksum of the codex32 strings
+	ms32 = &bch{
+		length: 13,
+		init:   uint128{0, 0x23181b3},
+		gen: [5]uint128{
+			{0x1, 0x9dc500ce73fde210},
+			{0x1, 0xbfae00def77fe529},
+			{0x1, 0xfbd920fffe7bee52},
+			{0x1, 0x739640bdeee3fdad},
+			{0x0, 0x7729a039cfc75f5a},
+		},
+		target: uint128{0x1, 0x0ce0795c2fd1e62a},
+	}
+	// ms32Long is the 15 characters checksum of the long codex32 strings
+	ms32Long = &bch{
+		length: 15,
+		init:   uint128{0, 0x23181b3},
+		gen: [5]uint128{
+			{0x3d5, 0x9d273535ea62d897},
+			{0x7a9, 0xbecb6361c6c51507},
+			{0x543, 0xf9b7e6c38d8a2a0e},
+			{0x0c5, 0x77eaeccf1990d13c},
+			{0x188, 0x7f74f8dc71b10651},
+		},
+		target: uint128{0x433, 0x81e570bf4798ab26},
+	}
+)
+
+func (c *bch) polymod(values []byte) uint128 {
+	bits := uint(c.length * 5)
+	residue := c.init
+	for _, v := range values {
+		b := topBits(residue, bits)
+		// drop the top 5 bits then shift in v
+		residue = lowBits(residue, bits-5)
+		residue = uint128{residue.hi<<5 | residue.lo>>59, residue.lo<<5 | uint64(v)}
+		for i := range c.gen {
+			if b>>i&1 == 1 {
+				residue = residue.xor(c.gen[i])
+			}
+		}
+	}
+	return residue
+}
+
+// topBits returns x >> (n-5), the top 5 bits of a residue of n bits
+func topBits(x uint128, n uint) uint64 {
+	shift := n - 5
+	if shift >= 64 {
+		return x.hi >> (shift - 64)
+	}
+	return x.hi<<(64-shift) | x.lo>>shift
+}
+
+// lowBits keeps the n low bits of x
+func lowBits(x uint128, n uint) uint128 {
+	if n >= 64 {
+		return uint128{x.hi & (1<<(n-64) - 1), x.lo}
+	}
+	return uint128{0, x.lo & (1<<n - 1)}
+}
+
+func (c *bch) verify(values []byte) bool {
+	return c.polymod(values) == c.target
+}
+
+func (c *bch) create(values []byte) []byte {
+	residue := c.polymod(append(append([]byte{}, values...), make([]byte, c.length)...)).xor(c.target)
+	checksum := make([]byte, c.length)
+	for i := range checksum {
+		shift := uint(5 * (c.length - 1 - i))
+		checksum[i] = byte(topBits(residue, shift+5) & 31)
+	}
+	return checksum
+}
@@ -0,0 +1,347 @@
+// Package codex32 implements the BIP93 codex32 scheme for backing up and
+// splitting BIP32 master seeds into shares that can be checksummed and
+// recombined by hand with paper computers.
+//
+// A codex32 string is "ms1" followed by the threshold digit, a 4 characters
+// identifier, the share index, the bech32 encoded payload and a BCH checksum.
+// The share with index "s" holds the master seed.
+package codex32
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/bech32"
+)
+
+// Error list
+var (
+	ErrInvalidLength    = errors.New("codex32: invalid length")
+	ErrInvalidPrefix    = errors.New("codex32: invalid prefix")
+	ErrInvalidChar      = errors.New("codex32: invalid character")
+	ErrMixedCase        = errors.New("codex32: mixed case")
+	ErrInvalidChecksum  = errors.New("codex32: invalid checksum")
+	ErrInvalidThreshold = errors.New("codex32: invalid threshold")
+	ErrInvalidIndex     = errors.New("codex32: invalid share index")
+	ErrInvalidPadding   = errors.New("codex32: invalid padding")
+	ErrMismatch         = errors.New("codex32: shares do not belong together")
+	ErrShareCount       = errors.New("codex32: not enough shares")
+)
+
+const (
+	prefix = "ms1"
+	// SecretIndex is the share index of the master seed
+	SecretIndex = 's'
+	// headerLen is the threshold, identifier and share index characters
+	headerLen = 6
+)
+
+// data part lengths of the short and the long codex32 strings, checksum included
+const (
+	shortMinLen = 45
+	shortMaxLen = 90
+	longMinLen  = 122
+	longMaxLen  = 124
+)
+
+// checksumOf returns the checksum of a data part of n characters, checksum included
+func checksumOf(n int) *bch {
+	if n > shortMaxLen {
+		return ms32Long
+	}
+	return ms32
+}
+
+// Share is a codex32 string
+type Share struct {
+	// data is the data part as GF(32) values, checksum included
+	data []byte
+}
+
+// Parse parses a codex32 string, upper case strings are accepted
+func Parse(s string) (*Share, error) {
+	if n := len(s) - len(prefix); (n < shortMinLen || n > shortMaxLen) && (n < longMinLen || n > longMaxLen) {
+		return nil, ErrInvalidLength
+	}
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return nil, ErrMixedCase
+	}
+	if !strings.HasPrefix(lower, prefix) {
+		return nil, ErrInvalidPrefix
+	}
+
+	data := make([]byte, 0, len(lower)-len(prefix))
+	for _, c := range lower[len(prefix):] {
+		v := strings.IndexRune(bech32.Charset, c)
+		if v < 0 {
+			return nil, ErrInvalidChar
+		}
+		data = append(data, byte(v))
+	}
+	if !checksumOf(len(data)).verify(data) {
+		return nil, ErrInvalidChecksum
+	}
+
+	share := &Share{data: data}
+	if err := share.validate(); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+func (s *Share) validate() error {
+	k := bech32.Charset[s.data[0]]
+	if k != '0' && (k < '2' || k > '9') {
+		return ErrInvalidThreshold
+	}
+	if k == '0' && s.Index() != SecretIndex {
+		return ErrInvalidIndex
+	}
+	if bits := len(s.payloadData()) * 5 % 8; bits > 4 {
+		return ErrInvalidPadding
+	}
+	return nil
+}
+
+// New returns the codex32 string of the payload. Threshold is 0 for a master
+// seed which is not split, or between 2 and 9. The payload is 16 to 44 bytes
+// for a short string or 63 to 64 bytes for a long one.
+func New(threshold int, id string, index byte, payload []byte) (*Share, error) {
+	if threshold != 0 && (threshold < 2 || threshold > 9) {
+		return nil, ErrInvalidThreshold
+	}
+	if n := len(payload); (n < 16 || n > 44) && (n < 63 || n > 64) {
+		return nil, ErrInvalidLength
+	}
+	if len(id) != 4 {
+		return nil, fmt.Errorf("codex32: identifier must be 4 characters")
+	}
+
+	header := fmt.Sprintf("%d%s%c", threshold, strings.ToLower(id), index)
+	data := make([]byte, 0, 128)
+	for _, c := range header {
+		v := strings.IndexRune(bech32.Charset, c)
+		if v < 0 {
+			return nil, ErrInvalidChar
+		}
+		data = append(data, byte(v))
+	}
+	data = append(data, toBase32(payload)...)
+
+	checksum := checksumOf(len(data) + ms32.length)
+	share := &Share{data: append(data, checksum.create(data)...)}
+	if err := share.validate(); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// toBase32 regroups bytes into 5 bits values, padding with zero bits
+func toBase32(b []byte) []byte {
+	out := make([]byte, 0, (len(b)*8+4)/5)
+	var acc, bits uint
+	for _, c := range b {
+		acc = acc<<8 | uint(c)
+		for bits += 8; bits >= 5; bits -= 5 {
+			out = append(out, byte(acc>>(bits-5)&31))
+		}
+	}
+	if bits > 0 {
+		out = append(out, byte(acc<<(5-bits)&31))
+	}
+	return out
+}
+
+// checksumLen returns the length of the share's checksum
+func (s *Share) checksumLen() int {
+	return checksumOf(len(s.data)).length
+}
+
+func (s *Share) payloadData() []byte {
+	return s.data[headerLen : len(s.data)-s.checksumLen()]
+}
+
+// Threshold returns the number of shares required, 0 for an unshared seed
+func (s *Share) Threshold() int {
+	return int(bech32.Charset[s.data[0]] - '0')
+}
+
+// ID returns the identifier of the share set
+func (s *Share) ID() string {
+	return s.chars(1, 5)
+}
+
+// Index returns the share index character
+func (s *Share) Index() byte {
+	return bech32.Charset[s.data[5]]
+}
+
+// Payload returns the payload bytes, the padding bits are dropped
+func (s *Share) Payload() []byte {
+	values := s.payloadData()
+	out := make([]byte, 0, len(values)*5/8)
+	var acc, bits uint
+	for _, v := range values {
+		acc = acc<<5 | uint(v)
+		if bits += 5; bits >= 8 {
+			bits -= 8
+			out = append(out, byte(acc>>bits))
+		}
+	}
+	return out
+}
+
+// String returns the lower case codex32 string
+func (s *Share) String() string {
+	return prefix + s.chars(0, len(s.data))
+}
+
+func (s *Share) chars(from, to int) string {
+	var sb strings.Builder
+	for _, v := range s.data[from:to] {
+		sb.WriteByte(bech32.Charset[v])
+	}
+	return sb.String()
+}
+
+// Interpolate derives the share of index from threshold shares of a set, the
+// secret share is derived with the SecretIndex
+func Interpolate(shares []*Share, index byte) (*Share, error) {
+	if len(shares) == 0 {
+		return nil, ErrShareCount
+	}
+	x := strings.IndexByte(bech32.Charset, index)
+	if x < 0 {
+		return nil, ErrInvalidIndex
+	}
+	for _, s := range shares {
+		if s.Index() == index {
+			return s, nil
+		}
+	}
+	first := shares[0]
+	k := first.Threshold()
+	if k == 0 {
+		k = 1
+	}
+	if len(shares) < k {
+		return nil, ErrShareCount
+	}
+	shares = shares[:k]
+	seen := make(map[byte]bool, k)
+	for _, s := range shares {
+		if s.Threshold() != first.Threshold() || s.ID() != first.ID() || len(s.data) != len(first.data) || seen[s.Index()] {
+			return nil, ErrMismatch
+		}
+		seen[s.Index()] = true
+	}
+
+	weights := lagrange(shares, byte(x))
+	data := make([]byte, len(first.data))
+	for i := range data {
+		for j, s := range shares {
+			data[i] ^= gfMul(weights[j], s.data[i])
+		}
+	}
+	return &Share{data: data}, nil
+}
+
+// lagrange returns the Lagrange basis of the shares evaluated at x
+func lagrange(shares []*Share, x byte) []byte {
+	var n byte = 1
+	weights := make([]byte, len(shares))
+	for i, si := range shares {
+		n = gfMul(n, si.data[5]^x)
+		var m byte = 1
+		for _, sj := range shares {
+			if si == sj {
+				m = gfMul(m, x^sj.data[5])
+			} else {
+				m = gfMul(m, si.data[5]^sj.data[5])
+			}
+		}
+		weights[i] = m
+	}
+	for i, m := range weights {
+		weights[i] = gfMul(n, gfInv(m))
+	}
+	return weights
+}
+
+// Recover returns the master seed of the shares
+func Recover(shares ...*Share) ([]byte, error) {
+	secret, err := Interpolate(shares, SecretIndex)
+	if err != nil {
+		return nil, err
+	}
+	return secret.Payload(), nil
+}
+
+// Split returns n shares of the master seed with threshold. The threshold-1
+// first shares are random, read from r, and the others are interpolated.
+// The crypto/rand reader is used if r is nil.
+func Split(seed []byte, threshold, n int, id string, r io.Reader) ([]*Share, error) {
+	if threshold < 2 || threshold > 9 || n < threshold || n > 31 {
+		return nil, ErrInvalidThreshold
+	}
+	if r == nil {
+		r = rand.Reader
+	}
+	secret, err := New(threshold, id, SecretIndex, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	// share indexes in the alphabetical order of the bech32 characters
+	var indexes []byte
+	for _, c := range []byte("acdefghjklmnpqrstuvwxyz023456789") {
+		if c != SecretIndex {
+			indexes = append(indexes, c)
+		}
+	}
+	basis := []*Share{secret}
+	random := make([]byte, len(seed))
+	for _, index := range indexes[:threshold-1] {
+		if _, err := io.ReadFull(r, random); err != nil {
+			return nil, err
+		}
+		share, err := New(threshold, id, index, random)
+		if err != nil {
+			return nil, err
+		}
+		basis = append(basis, share)
+	}
+
+	shares := append([]*Share{}, basis[1:]...)
+	for _, index := range indexes[threshold-1 : n] {
+		share, err := Interpolate(basis, index)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, nil
+}
+
+// FromMnemonic returns the unshared codex32 secret of the mnemonic entropy
+func FromMnemonic(mnemonic string, lang bip39.Language, id string) (*Share, error) {
+	entropy, err := bip39.MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	return New(0, id, SecretIndex, entropy)
+}
+
+// Mnemonic returns the mnemonic of a secret share's payload, the payload is
+// the BIP39 entropy so only 16 to 32 bytes secrets are supported
+func (s *Share) Mnemonic(lang bip39.Language) (string, error) {
+	if s.Index() != SecretIndex {
+		return "", ErrInvalidIndex
+	}
+	return bip39.NewMnemonicByEntropy(s.Payload(), lang)
+}
@@ -0,0 +1,256 @@
+package codex32
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip39"
+)
+
+// BIP93 test vectors
+func TestRecover(t *testing.T) {
+	tests := []struct {
+		name   string
+		shares []string
+		secret string
+	}{
+		{
+			name:   "vector 1",
+			shares: []string{"ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlw"},
+			secret: "318c6318c6318c6318c6318c6318c631",
+		},
+		{
+			name:   "vector 2",
+			shares: []string{"MS12NAMEA320ZYXWVUTSRQPNMLKJHGFEDCAXRPP870HKKQRM", "MS12NAMECACDEFGHJKLMNPQRSTUVWXYZ023FTR2GDZMPY6PN"},
+			secret: "d1808e096b35b209ca12132b264662a5",
+		},
+		{
+			name:   "vector 3",
+			shares: []string{"ms13cashsllhdmn9m42vcsamx24zrxgs3qqjzqud4m0d6nln"},
+			secret: "ffeeddccbbaa99887766554433221100",
+		},
+		{
+			name:   "vector 3 shares",
+			shares: []string{"ms13casha320zyxwvutsrqpnmlkjhgfedca2a8d0zehn8a0t", "ms13cashcacdefghjklmnpqrstuvwxyz023949xq35my48dr", "ms13cashd0wsedstcdcts64cd7wvy4m90lm28w4ffupqs7rm"},
+			secret: "ffeeddccbbaa99887766554433221100",
+		},
+		{
+			name:   "vector 4",
+			shares: []string{"ms10leetsllhdmn9m42vcsamx24zrxgs3qrl7ahwvhw4fnzrhve25gvezzyqqtum9pgv99ycma"},
+			secret: "ffeeddccbbaa99887766554433221100ffeeddccbbaa99887766554433221100",
+		},
+		{
+			name:   "vector 5",
+			shares: []string{"MS100C8VSM32ZXFGUHPCHTLUPZRY9X8GF2TVDW0S3JN54KHCE6MUA7LQPZYGSFJD6AN074RXVCEMLH8WU3TK925ACDEFGHJKLMNPQRSTUVWXY06FHPV80UNDVARHRAK"},
+			secret: "dc5423251cb87175ff8110c8531d0952d8d73e1194e95b5f19d6f9df7c01111104c9baecdfea8cccc677fb9ddc8aec5553b86e528bcadfdcc201c17c638c47e9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var shares []*Share
+			for _, s := range tt.shares {
+				share, err := Parse(s)
+				if err != nil {
+					t.Fatalf("Parse(%s) error = %v", s, err)
+				}
+				shares = append(shares, share)
+			}
+			got, err := Recover(shares...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got) != tt.secret {
+				t.Errorf("Recover() = %x, want %s", got, tt.secret)
+			}
+		})
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	var shares []*Share
+	for _, s := range []string{
+		"ms13casha320zyxwvutsrqpnmlkjhgfedca2a8d0zehn8a0t",
+		"ms13cashcacdefghjklmnpqrstuvwxyz023949xq35my48dr",
+		"ms13cashd0wsedstcdcts64cd7wvy4m90lm28w4ffupqs7rm",
+	} {
+		share, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares = append(shares, share)
+	}
+	tests := []struct {
+		index byte
+		want  string
+	}{
+		{'e', "ms13casheekgpemxzshcrmqhaydlp6yhms3ws7320xyxsar9"},
+		{'f', "ms13cashf8jh6sdrkpyrsp5ut94pj8ktehhw2hfvyrj48704"},
+		{'s', "ms13cashsllhdmn9m42vcsamx24zrxgs3qqjzqud4m0d6nln"},
+	}
+	for _, tt := range tests {
+		got, err := Interpolate(shares, tt.index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("Interpolate(%c) = %s, want %s", tt.index, got, tt.want)
+		}
+	}
+
+	other, _ := Parse("MS12NAMEA320ZYXWVUTSRQPNMLKJHGFEDCAXRPP870HKKQRM")
+	if _, err := Interpolate([]*Share{shares[0], shares[1], other}, 's'); err != ErrMismatch {
+		t.Errorf("Interpolate() error = %v, want %v", err, ErrMismatch)
+	}
+	if _, err := Interpolate(shares[:2], 's'); err != ErrShareCount {
+		t.Errorf("Interpolate() error = %v, want %v", err, ErrShareCount)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{"checksum", "ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlq", ErrInvalidChecksum},
+		{"mixed case", "ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczLw", ErrMixedCase},
+		{"prefix", "mx10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlw", ErrInvalidPrefix},
+		{"character", "ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczlb", ErrInvalidChar},
+		{"short", "ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxx4nzvca9cmczl", ErrInvalidLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.input); err != tt.want {
+				t.Errorf("Parse() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	payload, _ := hex.DecodeString("318c6318c6318c6318c6318c6318c631")
+	share, err := New(0, "test", SecretIndex, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the vector sets the 2 padding bits which New leaves to zero
+	if want := "ms10testsxxxxxxxxxxxxxxxxxxxxxxxxxywvfucx7rv8mk8"; share.String() != want {
+		t.Errorf("New() = %s, want %s", share, want)
+	}
+	if _, err := Parse(share.String()); err != nil {
+		t.Error(err)
+	}
+	if _, err := New(1, "test", SecretIndex, payload); err != ErrInvalidThreshold {
+		t.Errorf("New() error = %v, want %v", err, ErrInvalidThreshold)
+	}
+	if _, err := New(0, "test", 'a', payload); err != ErrInvalidIndex {
+		t.Errorf("New() error = %v, want %v", err, ErrInvalidIndex)
+	}
+
+	for _, n := range []int{15, 45, 62, 65} {
+		if _, err := New(0, "test", SecretIndex, make([]byte, n)); err != ErrInvalidLength {
+			t.Errorf("New() %d bytes error = %v, want %v", n, err, ErrInvalidLength)
+		}
+	}
+	for _, n := range []int{16, 44, 63, 64} {
+		share, err := New(0, "test", SecretIndex, make([]byte, n))
+		if err != nil {
+			t.Fatalf("New() %d bytes error = %v", n, err)
+		}
+		if _, err := Parse(share.String()); err != nil {
+			t.Errorf("Parse() %d bytes error = %v", n, err)
+		}
+	}
+
+	// 512 bits seeds use the long checksum
+	long, err := New(0, "leet", SecretIndex, bytes.Repeat([]byte{0xa5}, 64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(long.String()); n < 125 || n > 127 {
+		t.Fatalf("New() length = %d", n)
+	}
+	parsed, err := Parse(strings.ToUpper(long.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(parsed.Payload(), bytes.Repeat([]byte{0xa5}, 64)) {
+		t.Errorf("Payload() = %x", parsed.Payload())
+	}
+}
+
+func TestSplit(t *testing.T) {
+	seed, _ := hex.DecodeString("ffeeddccbbaa99887766554433221100ffeeddccbbaa99887766554433221100")
+	shares, err := Split(seed, 3, 5, "cash", bytes.NewReader(bytes.Repeat([]byte{0x42, 0x17}, 64)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("Split() returns %d shares", len(shares))
+	}
+	for i := 0; i+3 <= len(shares); i++ {
+		var set []*Share
+		for _, s := range shares[i : i+3] {
+			parsed, err := Parse(s.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			set = append(set, parsed)
+		}
+		got, err := Recover(set...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, seed) {
+			t.Errorf("Recover() = %x", got)
+		}
+	}
+}
+
+func TestSplit_CryptoRand(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x5a}, 16)
+	shares, err := Split(seed, 2, 3, "rand", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Recover(shares[0], shares[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, seed) {
+		t.Errorf("Recover() = %x", got)
+	}
+}
+
+func TestMnemonic(t *testing.T) {
+	mnemonic := "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong"
+	share, err := FromMnemonic(mnemonic, bip39.English, "zzzz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(share.Payload()) != "ffffffffffffffffffffffffffffffff" {
+		t.Errorf("Payload() = %x", share.Payload())
+	}
+	got, err := share.Mnemonic(bip39.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != mnemonic {
+		t.Errorf("Mnemonic() = %s, want %s", got, mnemonic)
+	}
+
+	a, _ := Parse("ms13casha320zyxwvutsrqpnmlkjhgfedca2a8d0zehn8a0t")
+	if _, err := a.Mnemonic(bip39.English); err != ErrInvalidIndex {
+		t.Errorf("Mnemonic() error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
+
+func TestField(t *testing.T) {
+	for a := byte(1); a < 32; a++ {
+		if gfMul(a, gfInv(a)) != 1 {
+			t.Errorf("gfInv(%d) is not the inverse", a)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package codex32
+
+// gfMul multiplies in GF(32) with the bech32 modulus x^5 + x^3 + 1
+func gfMul(a, b byte) byte {
+	var res byte
+	for i := 0; i < 5; i++ {
+		if b>>i&1 == 1 {
+			res ^= a
+		}
+		a <<= 1
+		if a&32 != 0 {
+			a ^= 41
+		}
+	}
+	return res
+}
+
+var gfInverse = [32]byte{0, 1, 20, 24, 10, 8, 12, 29, 5, 11, 4, 9, 6, 28, 26, 31, 22, 18, 17, 23, 2, 25, 16, 19, 3, 21, 14, 30, 13, 7, 27, 15}
+
+func gfInv(a byte) byte {
+	return gfInverse[a]
+}
@@ -0,0 +1,90 @@
+package descriptor
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	inputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// Error list
+var (
+	ErrInvalidCharacter = errors.New("descriptor: invalid character")
+	ErrChecksumMismatch = errors.New("descriptor: checksum mismatch")
+)
+
+func polymod(c uint64, val int) uint64 {
+	gen := [5]uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+	top := c >> 35
+	c = (c&0x7ffffffff)<<5 ^ uint64(val)
+	for i := 0; i < 5; i++ {
+		if (top>>uint(i))&1 == 1 {
+			c ^= gen[i]
+		}
+	}
+	return c
+}
+
+// Checksum returns the 8 characters BIP380 checksum of a descriptor without checksum
+func Checksum(desc string) (string, error) {
+	c := uint64(1)
+	var cls, clsCount int
+	for _, ch := range desc {
+		pos := strings.IndexRune(inputCharset, ch)
+		if pos < 0 {
+			return "", ErrInvalidCharacter
+		}
+		// emit a symbol for the position inside the group, for every character
+		c = polymod(c, pos&31)
+		// accumulate the group numbers
+		cls = cls*3 + pos>>5
+		if clsCount++; clsCount == 3 {
+			// emit an extra symbol representing the group numbers, for every 3 characters
+			c = polymod(c, cls)
+			cls, clsCount = 0, 0
+		}
+	}
+	if clsCount > 0 {
+		c = polymod(c, cls)
+	}
+	// shift further to determine the checksum
+	for i := 0; i < 8; i++ {
+		c = polymod(c, 0)
+	}
+	// prevent appending zeroes from not affecting the checksum
+	c ^= 1
+
+	var sb strings.Builder
+	for i := 0; i < 8; i++ {
+		sb.WriteByte(checksumCharset[(c>>(5*(7-uint(i))))&31])
+	}
+	return sb.String(), nil
+}
+
+// AddChecksum appends #checksum to a descriptor
+func AddChecksum(desc string) (string, error) {
+	sum, err := Checksum(desc)
+	if err != nil {
+		return "", err
+	}
+	return desc + "#" + sum, nil
+}
+
+// VerifyChecksum checks a descriptor with #checksum suffix
+func VerifyChecksum(desc string) error {
+	pos := strings.LastIndexByte(desc, '#')
+	if pos < 0 {
+		return ErrChecksumMismatch
+	}
+	sum, err := Checksum(desc[:pos])
+	if err != nil {
+		return err
+	}
+	if sum != desc[pos+1:] {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
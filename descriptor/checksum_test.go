@@ -0,0 +1,50 @@
+package descriptor
+
+import "testing"
+
+func TestChecksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		desc    string
+		want    string
+		wantErr error
+	}{
+		{"raw", "raw(deadbeef)", "89f8spxm", nil},
+		{
+			name: "pkh with origin",
+			desc: "pkh([d34db33f/44'/0'/0']xpub6ERApfZwUNrhLCkDtcHTcxd75RbzS1ed54G1LkBUHQVHQKqhMkhgbmJbZRkrgZw4koxb5JaHWkY4ALHY2grBGRjaDMzQLcgJvLJuZZvRcEL/1/*)",
+			want: "ml40v0wf",
+		},
+		{"invalid character", "raw(deadbeef)é", "", ErrInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Checksum(tt.desc)
+			if err != tt.wantErr {
+				t.Errorf("Checksum() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Checksum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	tests := []struct {
+		desc    string
+		wantErr error
+	}{
+		{"raw(deadbeef)#89f8spxm", nil},
+		{"raw(deadbeef)#89f8spxn", ErrChecksumMismatch},
+		{"raw(deadbeef)", ErrChecksumMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if err := VerifyChecksum(tt.desc); err != tt.wantErr {
+				t.Errorf("VerifyChecksum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
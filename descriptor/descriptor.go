@@ -0,0 +1,193 @@
+// Package descriptor generates BIP380 output descriptors for BIP39 seeds.
+//
+// Every key carries its origin as [fingerprint/path] and every descriptor carries
+// its checksum, so the output can be imported into Bitcoin Core or Sparrow as is.
+// Seeds are the 64 bytes returned by bip39.MnemonicToSeed.
+package descriptor
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// Network selects the coin type and the extended key version
+type Network int
+
+// Network list
+const (
+	Mainnet Network = iota
+	Testnet
+)
+
+// ScriptType is the single-sig output script type
+type ScriptType int
+
+// ScriptType list
+const (
+	PKH    ScriptType = iota // BIP44 pkh()
+	ShWPKH                   // BIP49 sh(wpkh())
+	WPKH                     // BIP84 wpkh()
+	TR                       // BIP86 tr()
+)
+
+// Error list
+var (
+	ErrUnknownScriptType = errors.New("descriptor: unknown script type")
+	ErrUnknownNetwork    = errors.New("descriptor: unknown network")
+	ErrInvalidThreshold  = errors.New("descriptor: invalid multisig threshold")
+	ErrInvalidIndex      = errors.New("descriptor: account or coin index is hardened")
+)
+
+// maxMultisigKeys is the limit of keys in a wsh multisig
+const maxMultisigKeys = 20
+
+// Descriptor holds the receive and change descriptors with checksums
+type Descriptor struct {
+	Receive string
+	Change  string
+}
+
+// Purpose returns the BIP43 purpose of the script type
+func (s ScriptType) Purpose() (uint32, error) {
+	switch s {
+	case PKH:
+		return 44, nil
+	case ShWPKH:
+		return 49, nil
+	case WPKH:
+		return 84, nil
+	case TR:
+		return 86, nil
+	}
+	return 0, ErrUnknownScriptType
+}
+
+func (s ScriptType) wrap(key string) string {
+	switch s {
+	case PKH:
+		return "pkh(" + key + ")"
+	case ShWPKH:
+		return "sh(wpkh(" + key + "))"
+	case WPKH:
+		return "wpkh(" + key + ")"
+	default:
+		return "tr(" + key + ")"
+	}
+}
+
+func (n Network) coinType() (uint32, error) {
+	switch n {
+	case Mainnet:
+		return 0, nil
+	case Testnet:
+		return 1, nil
+	}
+	return 0, ErrUnknownNetwork
+}
+
+// SingleSig returns the descriptors of the account at the BIP44, BIP49, BIP84 or BIP86 path
+func SingleSig(seed []byte, script ScriptType, account uint32, net Network) (*Descriptor, error) {
+	purpose, err := script.Purpose()
+	if err != nil {
+		return nil, err
+	}
+	coin, err := net.coinType()
+	if err != nil {
+		return nil, err
+	}
+	path, err := hardenedPath(purpose, coin, account)
+	if err != nil {
+		return nil, err
+	}
+	key, err := AccountKey(seed, path, net)
+	if err != nil {
+		return nil, err
+	}
+	return build(func(branch int) string {
+		return script.wrap(fmt.Sprintf("%s/%d/*", key, branch))
+	})
+}
+
+// hardenedPath returns the hardened indexes, which must be below hdkey.Hardened
+func hardenedPath(indexes ...uint32) ([]uint32, error) {
+	path := make([]uint32, len(indexes))
+	for i, index := range indexes {
+		if index >= hdkey.Hardened {
+			return nil, ErrInvalidIndex
+		}
+		path[i] = index + hdkey.Hardened
+	}
+	return path, nil
+}
+
+// SortedMulti returns the wsh(sortedmulti(k,...)) descriptors of the seeds
+// at the BIP48 native segwit path m/48'/coin'/account'/2'
+func SortedMulti(threshold int, seeds [][]byte, account uint32, net Network) (*Descriptor, error) {
+	if threshold < 1 || threshold > len(seeds) || len(seeds) > maxMultisigKeys {
+		return nil, ErrInvalidThreshold
+	}
+	coin, err := net.coinType()
+	if err != nil {
+		return nil, err
+	}
+	path, err := hardenedPath(48, coin, account, 2)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(seeds))
+	for i, seed := range seeds {
+		keys[i], err = AccountKey(seed, path, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return build(func(branch int) string {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "wsh(sortedmulti(%d", threshold)
+		for _, key := range keys {
+			fmt.Fprintf(&sb, ",%s/%d/*", key, branch)
+		}
+		sb.WriteString("))")
+		return sb.String()
+	})
+}
+
+// AccountKey returns the key expression [fingerprint/path]xpub of the seed at the path
+func AccountKey(seed []byte, path []uint32, net Network) (string, error) {
+	master, err := hdkey.NewMaster(seed)
+	if err != nil {
+		return "", err
+	}
+	account, err := master.Derive(path)
+	if err != nil {
+		return "", err
+	}
+	pub := account.Neuter()
+	switch net {
+	case Mainnet:
+		pub = pub.WithVersion(hdkey.VersionXpub)
+	case Testnet:
+		pub = pub.WithVersion(hdkey.VersionTpub)
+	default:
+		return "", ErrUnknownNetwork
+	}
+	fp := master.Fingerprint()
+	origin := hex.EncodeToString(fp[:]) + strings.TrimPrefix(hdkey.FormatPath(path), "m")
+	return "[" + origin + "]" + pub.String(), nil
+}
+
+func build(desc func(branch int) string) (*Descriptor, error) {
+	receive, err := AddChecksum(desc(0))
+	if err != nil {
+		return nil, err
+	}
+	change, err := AddChecksum(desc(1))
+	if err != nil {
+		return nil, err
+	}
+	return &Descriptor{Receive: receive, Change: change}, nil
+}
@@ -0,0 +1,114 @@
+package descriptor
+
+import (
+	"testing"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+const (
+	abandonMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	checkMnemonic   = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+)
+
+func TestSingleSig(t *testing.T) {
+	seed := bip39.MnemonicToSeed(abandonMnemonic, "")
+	tests := []struct {
+		name    string
+		script  ScriptType
+		net     Network
+		account uint32
+		want    Descriptor
+		wantErr error
+	}{
+		{
+			name:   "BIP44",
+			script: PKH,
+			want: Descriptor{
+				Receive: "pkh([73c5da0a/44'/0'/0']xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj/0/*)#8w4z8fed",
+				Change:  "pkh([73c5da0a/44'/0'/0']xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj/1/*)#k6sr6uf4",
+			},
+		},
+		{
+			name:   "BIP49",
+			script: ShWPKH,
+			want: Descriptor{
+				Receive: "sh(wpkh([73c5da0a/49'/0'/0']xpub6C6nQwHaWbSrzs5tZ1q7m5R9cPK9eYpNMFesiXsYrgc1P8bvLLAet9JfHjYXKjToD8cBRswJXXbbFpXgwsswVPAZzKMa1jUp2kVkGVUaJa7/0/*))#gvfpdstz",
+				Change:  "sh(wpkh([73c5da0a/49'/0'/0']xpub6C6nQwHaWbSrzs5tZ1q7m5R9cPK9eYpNMFesiXsYrgc1P8bvLLAet9JfHjYXKjToD8cBRswJXXbbFpXgwsswVPAZzKMa1jUp2kVkGVUaJa7/1/*))#ad8h407a",
+			},
+		},
+		{
+			name:   "BIP84",
+			script: WPKH,
+			want: Descriptor{
+				Receive: "wpkh([73c5da0a/84'/0'/0']xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V/0/*)#wc3n3van",
+				Change:  "wpkh([73c5da0a/84'/0'/0']xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V/1/*)#lv5jvedt",
+			},
+		},
+		{
+			name:   "BIP86",
+			script: TR,
+			want: Descriptor{
+				Receive: "tr([73c5da0a/86'/0'/0']xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ/0/*)#rg247h69",
+				Change:  "tr([73c5da0a/86'/0'/0']xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ/1/*)#ju05rz2a",
+			},
+		},
+		{name: "unknown script type", script: ScriptType(10), wantErr: ErrUnknownScriptType},
+		{name: "unknown network", script: WPKH, net: Network(10), wantErr: ErrUnknownNetwork},
+		{name: "hardened account", script: WPKH, account: hdkey.Hardened, wantErr: ErrInvalidIndex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SingleSig(seed, tt.script, tt.account, tt.net)
+			if err != tt.wantErr {
+				t.Errorf("SingleSig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("SingleSig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedMulti(t *testing.T) {
+	seeds := [][]byte{
+		bip39.MnemonicToSeed(abandonMnemonic, ""),
+		bip39.MnemonicToSeed(checkMnemonic, ""),
+	}
+	got, err := SortedMulti(2, seeds, 0, Mainnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Descriptor{
+		Receive: "wsh(sortedmulti(2,[73c5da0a/48'/0'/0'/2']xpub6DkFAXWQ2dHxq2vatrt9qyA3bXYU4ToWQwCHbf5XB2mSTexcHZCeKS1VZYcPoBd5X8yVcbXFHJR9R8UCVpt82VX1VhR28mCyxUFL4r6KFrf/0/*,[2357a443/48'/0'/0'/2']xpub6DvGRPPBrkXi7gZZdHHvd1b2M7E6Y1VdAfqTzhonvgsVpKoz5QEceuM9FSme3CBkPJLdbbPZiNLJcxM3enwDZt2NHwUQSNHgmX5xuEY5jCS/0/*))#6dk8w8rm",
+		Change:  "wsh(sortedmulti(2,[73c5da0a/48'/0'/0'/2']xpub6DkFAXWQ2dHxq2vatrt9qyA3bXYU4ToWQwCHbf5XB2mSTexcHZCeKS1VZYcPoBd5X8yVcbXFHJR9R8UCVpt82VX1VhR28mCyxUFL4r6KFrf/1/*,[2357a443/48'/0'/0'/2']xpub6DvGRPPBrkXi7gZZdHHvd1b2M7E6Y1VdAfqTzhonvgsVpKoz5QEceuM9FSme3CBkPJLdbbPZiNLJcxM3enwDZt2NHwUQSNHgmX5xuEY5jCS/1/*))#r79rq5kw",
+	}
+	if *got != want {
+		t.Errorf("SortedMulti() = %v, want %v", got, want)
+	}
+
+	for _, k := range []int{0, 3} {
+		if _, err := SortedMulti(k, seeds, 0, Mainnet); err != ErrInvalidThreshold {
+			t.Errorf("SortedMulti(%d) error = %v, want %v", k, err, ErrInvalidThreshold)
+		}
+	}
+	if _, err := SortedMulti(2, seeds, hdkey.Hardened, Mainnet); err != ErrInvalidIndex {
+		t.Errorf("SortedMulti() error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
+
+func TestAccountKey_Testnet(t *testing.T) {
+	seed := bip39.MnemonicToSeed(abandonMnemonic, "")
+	got, err := AccountKey(seed, []uint32{84 + 1<<31, 1 + 1<<31, 1 << 31}, Testnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[:len("[73c5da0a/84'/1'/0']tpub")] != "[73c5da0a/84'/1'/0']tpub" {
+		t.Errorf("AccountKey() = %v, want tpub with origin", got)
+	}
+}
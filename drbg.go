@@ -0,0 +1,59 @@
+package bip39
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+)
+
+// DRBG is the HMAC-DRBG of NIST SP 800-90A with SHA-256 and no prediction
+// resistance. It's deterministic, the same seed gives the same mnemonics, so
+// it's meant for reproducible test fixtures, or for stretching a finite
+// source such as dice rolls before mixing it.
+type DRBG struct {
+	k, v []byte
+}
+
+// NewDRBG instantiates a DRBG with the entropy input, the nonce and the
+// personalization string, the last two are optional
+func NewDRBG(entropy, nonce, personalization []byte) *DRBG {
+	d := &DRBG{
+		k: make([]byte, sha256.Size),
+		v: make([]byte, sha256.Size),
+	}
+	for i := range d.v {
+		d.v[i] = 0x01
+	}
+	seed := make([]byte, 0, len(entropy)+len(nonce)+len(personalization))
+	seed = append(append(append(seed, entropy...), nonce...), personalization...)
+	d.update(seed)
+	return d
+}
+
+// Read generates len(p) bytes, every Read is a generate request so the
+// output depends on the size of the reads, it never fails
+func (d *DRBG) Read(p []byte) (int, error) {
+	for n := 0; n < len(p); {
+		d.v = d.hmac(d.v)
+		n += copy(p[n:], d.v)
+	}
+	d.update(nil)
+	return len(p), nil
+}
+
+func (d *DRBG) update(data []byte) {
+	d.k = d.hmac(d.v, []byte{0x00}, data)
+	d.v = d.hmac(d.v)
+	if len(data) == 0 {
+		return
+	}
+	d.k = d.hmac(d.v, []byte{0x01}, data)
+	d.v = d.hmac(d.v)
+}
+
+func (d *DRBG) hmac(data ...[]byte) []byte {
+	mac := hmac.New(sha256.New, d.k)
+	for _, b := range data {
+		_, _ = mac.Write(b)
+	}
+	return mac.Sum(nil)
+}
@@ -0,0 +1,41 @@
+package bip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestDRBG(t *testing.T) {
+	// NIST CAVP HMAC_DRBG SHA-256, no prediction resistance, COUNT = 0, the
+	// returned bits are the second generate output
+	entropy, _ := hex.DecodeString("ca851911349384bffe89de1cbdc46e6831e44d34a4fb935ee285dd14b71a7488")
+	nonce, _ := hex.DecodeString("659ba96c601dc69fc902940805ec0ca8")
+	want := "e528e9abf2dece54d47c7e75e5fe302149f817ea9fb4bee6f4199697d04d5b89d54fbb978a15b5c443c9ec21036d2460b6f73ebad0dc2aba6e624abf07745bc107694bb7547bb0995f70de25d6b29e2d3011bb19d27676c07162c8b5ccde0668961df86803482cb37ed6d5c0bb8d50cf1f50d476aa0458bdaba806f48be9dcb8"
+
+	d := NewDRBG(entropy, nonce, nil)
+	got := make([]byte, 128)
+	_, _ = d.Read(got)
+	_, _ = d.Read(got)
+	if hex.EncodeToString(got) != want {
+		t.Errorf("DRBG.Read() = %x, want %s", got, want)
+	}
+}
+
+func TestDRBG_Mnemonic(t *testing.T) {
+	m1, err := NewMnemonicWithOptions(24, English, MnemonicOptions{Rand: NewDRBG([]byte("fixture"), nil, nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2, _ := NewMnemonicWithOptions(24, English, MnemonicOptions{Rand: NewDRBG([]byte("fixture"), nil, nil)})
+	m3, _ := NewMnemonicWithOptions(24, English, MnemonicOptions{Rand: NewDRBG([]byte("fixture"), nil, []byte("other"))})
+	if m1 != m2 || m1 == m3 {
+		t.Errorf("DRBG mnemonics %q, %q, %q", m1, m2, m3)
+	}
+
+	long := make([]byte, 100)
+	_, _ = NewDRBG([]byte("fixture"), nil, nil).Read(long)
+	if bytes.Equal(long[:32], long[32:64]) {
+		t.Error("DRBG.Read() repeats its blocks")
+	}
+}
@@ -7,4 +7,10 @@ var (
 	ErrWordLen           = errors.New("invalid mnemonic list length")
 	ErrEntropyLen        = errors.New("invalid entropy length")
 	ErrChecksumIncorrect = errors.New("checksum incorrect")
+	ErrPartCount         = errors.New("at least two parts are required")
+	ErrPartLen           = errors.New("parts have different lengths")
+	ErrAezeed            = errors.New("mnemonic is an LND aezeed, not bip39")
+	ErrChineseScript     = errors.New("mnemonic is written with characters of the other chinese word list")
+	ErrKnownMnemonic     = errors.New("mnemonic is publicly known")
+	ErrWeakEntropy       = errors.New("entropy has a low quality pattern")
 )
@@ -0,0 +1,62 @@
+// Package fingerprint renders the BIP32 master key fingerprint of a seed in
+// forms easy to compare by eye: hex, BIP39 words, emoji and an identicon.
+//
+// A typo in a passphrase silently restores another, empty wallet, comparing
+// the fingerprint with the one noted at creation confirms the wallet before
+// funding it. It identifies a wallet but gives nothing away, it's the same
+// fingerprint as the one of output descriptors and PSBTs.
+package fingerprint
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// Fingerprint is the first 4 bytes of the hash160 of the master public key
+type Fingerprint [4]byte
+
+// New returns the master key fingerprint of a seed
+func New(seed []byte) (Fingerprint, error) {
+	master, err := hdkey.NewMaster(seed)
+	if err != nil {
+		return Fingerprint{}, err
+	}
+	return master.Fingerprint(), nil
+}
+
+// FromMnemonic validates the mnemonic and returns the master key fingerprint
+// of its seed with the passphrase
+func FromMnemonic(mnemonic, passphrase string, lang bip39.Language) (Fingerprint, error) {
+	if err := bip39.CheckMnemonic(mnemonic, lang); err != nil {
+		return Fingerprint{}, err
+	}
+	return New(bip39.MnemonicToSeed(mnemonic, passphrase))
+}
+
+// String returns the lower case hex of the fingerprint, such as "73c5da0a"
+func (f Fingerprint) String() string {
+	return hex.EncodeToString(f[:])
+}
+
+// Words returns 3 words of the list, the first 32 bits of their 33 bits
+// indexes are the fingerprint
+func (f Fingerprint) Words(lang bip39.Language) []string {
+	// the words of a mnemonic are the 11 bits groups of its entropy
+	entropy := make([]byte, 16)
+	copy(entropy, f[:])
+	mnemonic, _ := bip39.NewMnemonicByEntropy(entropy, lang)
+	return strings.Split(mnemonic, lang.Info().Separator)[:3]
+}
+
+// Emoji returns an emoji for every byte of the fingerprint, the 256 code
+// points from U+1F400 which are animals, food, people and objects
+func (f Fingerprint) Emoji() string {
+	var sb strings.Builder
+	for _, b := range f {
+		sb.WriteRune(0x1F400 + rune(b))
+	}
+	return sb.String()
+}
@@ -0,0 +1,71 @@
+package fingerprint
+
+import (
+	"testing"
+
+	"github.com/islishude/bip39"
+)
+
+const abandon = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestFromMnemonic(t *testing.T) {
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase string
+		want       string
+	}{
+		// BIP84 test vector, the master fingerprint of output descriptors
+		{name: "abandon", mnemonic: abandon, want: "73c5da0a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromMnemonic(tt.mnemonic, tt.passphrase, bip39.English)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("FromMnemonic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	fp, _ := FromMnemonic(abandon, "", bip39.English)
+	typo, _ := FromMnemonic(abandon, "TREZOR", bip39.English)
+	if fp == typo {
+		t.Error("FromMnemonic() ignores the passphrase")
+	}
+	if _, err := FromMnemonic("abandon abandon", "", bip39.English); err == nil {
+		t.Error("FromMnemonic() accepts an invalid mnemonic")
+	}
+	if _, err := New(make([]byte, 8)); err == nil {
+		t.Error("New() accepts a short seed")
+	}
+}
+
+func TestFingerprint_Words(t *testing.T) {
+	tests := []struct {
+		fp   Fingerprint
+		lang bip39.Language
+		want []string
+	}{
+		{fp: Fingerprint{}, lang: bip39.English, want: []string{"abandon", "abandon", "abandon"}},
+		{fp: Fingerprint{0xff, 0xff, 0xff, 0xff}, lang: bip39.English, want: []string{"zoo", "zoo", "zone"}},
+		// 926, 374 and 1044 are 01110011110 00101110110 10000010100
+		{fp: Fingerprint{0x73, 0xc5, 0xda, 0x0a}, lang: bip39.English, want: []string{"inherit", "conduct", "little"}},
+	}
+	for _, tt := range tests {
+		if got := tt.fp.Words(tt.lang); len(got) != 3 || got[0] != tt.want[0] || got[1] != tt.want[1] || got[2] != tt.want[2] {
+			t.Errorf("Fingerprint(%v).Words() = %v, want %v", tt.fp, got, tt.want)
+		}
+	}
+	if got := (Fingerprint{}).Words(bip39.Japanese); len(got) != 3 {
+		t.Errorf("Fingerprint.Words() = %q", got)
+	}
+}
+
+func TestFingerprint_Emoji(t *testing.T) {
+	if got, want := (Fingerprint{0x00, 0x01, 0x3f, 0xff}).Emoji(), "🐀🐁🐿📿"; got != want {
+		t.Errorf("Fingerprint.Emoji() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,109 @@
+package fingerprint
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+)
+
+// identicon is a 5x5 grid mirrored around its middle column, with a margin
+// of a cell
+const (
+	gridSize = 5
+	margin   = 1
+)
+
+var background = color.RGBA{R: 0xf0, G: 0xf0, B: 0xf0, A: 0xff}
+
+// cell reports whether the cell at column x and row y is filled, the 15
+// cells of the first 3 columns are the low bits of the fingerprint
+func (f Fingerprint) cell(x, y int) bool {
+	if x >= gridSize/2+1 {
+		x = gridSize - 1 - x
+	}
+	bits := uint32(f[1])<<16 | uint32(f[2])<<8 | uint32(f[3])
+	return bits>>(x*gridSize+y)&1 == 1
+}
+
+// color returns the identicon color, its hue is the first byte and the
+// 2 high bits of the second one
+func (f Fingerprint) color() color.RGBA {
+	hue := float64(uint16(f[0])<<2|uint16(f[1]>>6)) * 360 / 1024
+	return hsl(hue, 0.65, 0.45)
+}
+
+// Image renders the identicon, each cell is scale pixels
+func (f Fingerprint) Image(scale int) image.Image {
+	if scale < 1 {
+		scale = 1
+	}
+	side := (gridSize + 2*margin) * scale
+	img := image.NewRGBA(image.Rect(0, 0, side, side))
+	fg := f.color()
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			cx, cy := x/scale-margin, y/scale-margin
+			c := background
+			if cx >= 0 && cy >= 0 && cx < gridSize && cy < gridSize && f.cell(cx, cy) {
+				c = fg
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// PNG writes the identicon as a PNG image, each cell is scale pixels
+func (f Fingerprint) PNG(w io.Writer, scale int) error {
+	return png.Encode(w, f.Image(scale))
+}
+
+// SVG returns the identicon as an SVG image, each cell is scale pixels
+func (f Fingerprint) SVG(scale int) string {
+	if scale < 1 {
+		scale = 1
+	}
+	side := (gridSize + 2*margin) * scale
+	fg := f.color()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, side, side, side, side)
+	fmt.Fprintf(&sb, `<rect width="%d" height="%d" fill="#%02x%02x%02x"/>`, side, side, background.R, background.G, background.B)
+	fmt.Fprintf(&sb, `<g fill="#%02x%02x%02x">`, fg.R, fg.G, fg.B)
+	for y := 0; y < gridSize; y++ {
+		for x := 0; x < gridSize; x++ {
+			if f.cell(x, y) {
+				fmt.Fprintf(&sb, `<rect x="%d" y="%d" width="%d" height="%d"/>`, (x+margin)*scale, (y+margin)*scale, scale, scale)
+			}
+		}
+	}
+	sb.WriteString("</g></svg>")
+	return sb.String()
+}
+
+// hsl converts a color from HSL, hue in degrees, to RGB
+func hsl(h, s, l float64) color.RGBA {
+	c := (1 - math.Abs(2*l-1)) * s
+	hp := h / 60
+	x := c * (1 - math.Abs(math.Mod(hp, 2)-1))
+	var r, g, b float64
+	switch {
+	case hp < 1:
+		r, g = c, x
+	case hp < 2:
+		r, g = x, c
+	case hp < 3:
+		g, b = c, x
+	case hp < 4:
+		g, b = x, c
+	case hp < 5:
+		r, b = x, c
+	default:
+		r, b = c, x
+	}
+	m := l - c/2
+	return color.RGBA{R: uint8((r+m)*255 + 0.5), G: uint8((g+m)*255 + 0.5), B: uint8((b+m)*255 + 0.5), A: 0xff}
+}
@@ -0,0 +1,85 @@
+package fingerprint
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestFingerprint_Image(t *testing.T) {
+	fp := Fingerprint{0x73, 0xc5, 0xda, 0x0a}
+	img := fp.Image(4)
+	if b := img.Bounds(); b.Dx() != 28 || b.Dy() != 28 {
+		t.Fatalf("Image() bounds = %v", b)
+	}
+	for y := 0; y < gridSize; y++ {
+		for x := 0; x < gridSize; x++ {
+			if fp.cell(x, y) != fp.cell(gridSize-1-x, y) {
+				t.Errorf("cell(%d, %d) isn't mirrored", x, y)
+			}
+			want := color.Color(background)
+			if fp.cell(x, y) {
+				want = fp.color()
+			}
+			if got := img.At((x+margin)*4+1, (y+margin)*4+2); got != want {
+				t.Errorf("Image() at cell (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+	if img.At(0, 0) != color.Color(background) {
+		t.Error("Image() has no margin")
+	}
+
+	var buf bytes.Buffer
+	if err := fp.PNG(&buf, 4); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds() != img.Bounds() {
+		t.Errorf("PNG() bounds = %v", decoded.Bounds())
+	}
+
+	if other := (Fingerprint{0x73, 0xc5, 0xda, 0x0b}); other.Image(1) == nil || other.SVG(1) == fp.SVG(1) {
+		t.Error("identicons of different fingerprints are equal")
+	}
+}
+
+func TestFingerprint_SVG(t *testing.T) {
+	fp := Fingerprint{0x00, 0x00, 0x00, 0x01}
+	got := fp.SVG(10)
+	// a single cell in the first column, mirrored to the last one
+	for _, want := range []string{
+		`width="70" height="70"`,
+		`<rect x="10" y="10" width="10" height="10"/>`,
+		`<rect x="50" y="10" width="10" height="10"/>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("SVG() = %s, has no %s", got, want)
+		}
+	}
+	if n := strings.Count(got, "<rect"); n != 3 {
+		t.Errorf("SVG() has %d rects, want 3", n)
+	}
+}
+
+func Test_hsl(t *testing.T) {
+	tests := []struct {
+		h, s, l float64
+		want    color.RGBA
+	}{
+		{0, 1, 0.5, color.RGBA{R: 0xff, A: 0xff}},
+		{120, 1, 0.5, color.RGBA{G: 0xff, A: 0xff}},
+		{240, 1, 0.5, color.RGBA{B: 0xff, A: 0xff}},
+		{0, 0, 1, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}},
+	}
+	for _, tt := range tests {
+		if got := hsl(tt.h, tt.s, tt.l); got != tt.want {
+			t.Errorf("hsl(%v, %v, %v) = %v, want %v", tt.h, tt.s, tt.l, got, tt.want)
+		}
+	}
+}
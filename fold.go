@@ -0,0 +1,63 @@
+package bip39
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	foldedOnce     [Portuguese + 1]sync.Once
+	foldedMappings [Portuguese + 1]map[string]int64
+)
+
+// foldable reports whether the words of the language are unique without
+// their accents, Japanese is excluded as NFKD splits its dakuten too
+func (lan Language) foldable() bool {
+	switch lan {
+	case Spanish, French, Portuguese, Czech:
+		return true
+	}
+	return false
+}
+
+// foldedMapping returns word index mapping keyed by the words without their
+// accents, it's nil if the language isn't foldable
+func (lan Language) foldedMapping() map[string]int64 {
+	if !lan.foldable() {
+		return nil
+	}
+	foldedOnce[lan].Do(func() {
+		list := lan.list()
+		m := make(map[string]int64, len(list))
+		for idx, word := range list {
+			m[foldAccents(word)] = int64(idx)
+		}
+		foldedMappings[lan] = m
+	})
+	return foldedMappings[lan]
+}
+
+// foldAccents strips the combining marks of the NFKD form of the word
+func foldAccents(word string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(word))
+}
+
+// foldWord returns the list word of an input word typed with or without its
+// accents, the input word is returned as is if there is none
+func (lan Language) foldWord(word string) string {
+	if _, ok := lan.mapping()[word]; ok {
+		return word
+	}
+	if idx, ok := lan.foldedMapping()[foldAccents(word)]; ok {
+		return lan.list()[idx]
+	}
+	return word
+}
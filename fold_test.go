@@ -0,0 +1,64 @@
+package bip39
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFoldedMapping(t *testing.T) {
+	for lang := ChineseSimplified; lang <= Portuguese; lang++ {
+		m := lang.foldedMapping()
+		if !lang.foldable() {
+			if m != nil {
+				t.Errorf("%v.foldedMapping() should be nil", lang)
+			}
+			continue
+		}
+		// the words must stay unique without their accents
+		if len(m) != 2048 {
+			t.Errorf("%v.foldedMapping() has %d words", lang, len(m))
+		}
+	}
+}
+
+func TestParseMnemonicFoldAccents(t *testing.T) {
+	tests := []struct {
+		name    string
+		lang    Language
+		entropy []byte
+	}{
+		{"spanish", Spanish, make([]byte, 16)},
+		{"french", French, bytes.Repeat([]byte{0x5a}, 16)},
+		{"czech", Czech, bytes.Repeat([]byte{0xa7}, 32)},
+		{"portuguese", Portuguese, bytes.Repeat([]byte{0x33}, 24)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := NewMnemonicByEntropy(tt.entropy, tt.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			input := foldAccents(want)
+
+			got, err := ParseMnemonic(input, tt.lang, ParseOptions{FoldAccents: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("ParseMnemonic() = %q, want %q", got, want)
+			}
+			if input != want {
+				if _, err := ParseMnemonic(input, tt.lang, ParseOptions{}); err == nil {
+					t.Error("ParseMnemonic() should not fold accents by default")
+				}
+			}
+		})
+	}
+
+	if got := Spanish.foldWord("accion"); got != Spanish.foldWord("acción") || got == "accion" {
+		t.Errorf("foldWord(accion) = %q", got)
+	}
+	if got := Japanese.foldWord("ぱそこん"); got != "ぱそこん" {
+		t.Errorf("Japanese.foldWord() = %q", got)
+	}
+}
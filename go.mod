@@ -5,6 +5,14 @@ go 1.24.0
 toolchain go1.24.2
 
 require (
+	github.com/Yawning/aez v0.0.0-20211027044916-e49e68abd344
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
 	golang.org/x/crypto v0.45.0
+	golang.org/x/term v0.37.0
 	golang.org/x/text v0.31.0
 )
+
+require (
+	gitlab.com/yawning/bsaes.git v0.0.0-20190805113838-0a714cd429ec // indirect
+	golang.org/x/sys v0.38.0 // indirect
+)
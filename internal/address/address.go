@@ -0,0 +1,101 @@
+// Package address encodes bitcoin addresses of compressed secp256k1 public keys.
+package address
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/islishude/bip39/internal/base58"
+	"github.com/islishude/bip39/internal/bech32"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// Type is the output script type of an address
+type Type int
+
+// Type list
+const (
+	P2PKH Type = iota
+	P2SHP2WPKH
+	P2WPKH
+	P2TR
+)
+
+// Params holds the address prefixes of a network
+type Params struct {
+	PubKeyHashID byte
+	ScriptHashID byte
+	HRP          string
+}
+
+// Known network params
+var (
+	MainNet = Params{PubKeyHashID: 0x00, ScriptHashID: 0x05, HRP: "bc"}
+	TestNet = Params{PubKeyHashID: 0x6f, ScriptHashID: 0xc4, HRP: "tb"}
+)
+
+// ErrUnknownType is returned for an unsupported address type
+var ErrUnknownType = errors.New("address: unknown address type")
+
+// Encode returns the address of a 33 bytes compressed public key
+func Encode(t Type, pub []byte, net Params) (string, error) {
+	switch t {
+	case P2PKH:
+		return base58.CheckEncode(append([]byte{net.PubKeyHashID}, hdkey.Hash160(pub)...)), nil
+	case P2SHP2WPKH:
+		redeem := append([]byte{0x00, 0x14}, hdkey.Hash160(pub)...)
+		return base58.CheckEncode(append([]byte{net.ScriptHashID}, hdkey.Hash160(redeem)...)), nil
+	case P2WPKH:
+		return segwit(net.HRP, 0, hdkey.Hash160(pub)), nil
+	case P2TR:
+		output, err := taprootOutputKey(pub)
+		if err != nil {
+			return "", err
+		}
+		return segwit(net.HRP, 1, output), nil
+	}
+	return "", ErrUnknownType
+}
+
+func segwit(hrp string, version byte, program []byte) string {
+	data, _ := bech32.ConvertBits(program, 8, 5, true)
+	variant := bech32.Bech32
+	if version > 0 {
+		variant = bech32.Bech32m
+	}
+	return bech32.Encode(hrp, append([]byte{version}, data...), variant)
+}
+
+// taprootOutputKey returns the x-only BIP86 output key, the internal key tweaked without script path
+func taprootOutputKey(pub []byte) ([]byte, error) {
+	key, err := secp256k1.ParsePubKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	var point secp256k1.JacobianPoint
+	key.AsJacobian(&point)
+	// lift x to the point with even y
+	if point.Y.IsOdd() {
+		point.Y.Negate(1).Normalize()
+	}
+
+	xonly := pub[1:]
+	tag := sha256.Sum256([]byte("TapTweak"))
+	h := sha256.New()
+	_, _ = h.Write(tag[:])
+	_, _ = h.Write(tag[:])
+	_, _ = h.Write(xonly)
+
+	var tweak secp256k1.ModNScalar
+	if overflow := tweak.SetByteSlice(h.Sum(nil)); overflow {
+		return nil, errors.New("address: invalid taproot tweak")
+	}
+	var tweakPoint secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&tweak, &tweakPoint)
+	secp256k1.AddNonConst(&point, &tweakPoint, &point)
+	point.ToAffine()
+
+	out := point.X.Bytes()
+	return out[:], nil
+}
@@ -0,0 +1,49 @@
+package address
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// seed of abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about
+const abandonSeed = "5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc19a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4"
+
+func TestEncode(t *testing.T) {
+	seed, _ := hex.DecodeString(abandonSeed)
+	master, _ := hdkey.NewMaster(seed)
+	tests := []struct {
+		name string
+		path string
+		typ  Type
+		net  Params
+		want string
+	}{
+		{"BIP44", "m/44'/0'/0'/0/0", P2PKH, MainNet, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"},
+		{"BIP49", "m/49'/0'/0'/0/0", P2SHP2WPKH, MainNet, "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf"},
+		{"BIP84", "m/84'/0'/0'/0/0", P2WPKH, MainNet, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{"BIP84 change", "m/84'/0'/0'/1/0", P2WPKH, MainNet, "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el"},
+		{"BIP86", "m/86'/0'/0'/0/0", P2TR, MainNet, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+		{"BIP86 change", "m/86'/0'/0'/1/0", P2TR, MainNet, "bc1p3qkhfews2uk44qtvauqyr2ttdsw7svhkl9nkm9s9c3x4ax5h60wqwruhk7"},
+		{"BIP84 testnet", "m/84'/1'/0'/0/0", P2WPKH, TestNet, "tb1q6rz28mcfaxtmd6v789l9rrlrusdprr9pqcpvkl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := master.DerivePath(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Encode(tt.typ, key.PublicKey(), tt.net)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := Encode(Type(10), nil, MainNet); err != ErrUnknownType {
+		t.Errorf("Encode() error = %v, want %v", err, ErrUnknownType)
+	}
+}
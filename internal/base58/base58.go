@@ -0,0 +1,100 @@
+// Package base58 implements the Bitcoin base58 and base58check encodings.
+package base58
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Error list
+var (
+	ErrInvalidChar     = errors.New("base58: invalid character")
+	ErrInvalidChecksum = errors.New("base58: invalid checksum")
+	ErrTooShort        = errors.New("base58: input too short")
+)
+
+var decodeMap [256]int8
+
+func init() {
+	for i := range decodeMap {
+		decodeMap[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		decodeMap[alphabet[i]] = int8(i)
+	}
+}
+
+var bigRadix = big.NewInt(58)
+
+// Encode encodes b with the bitcoin alphabet
+func Encode(b []byte) string {
+	x := new(big.Int).SetBytes(b)
+	mod := new(big.Int)
+	out := make([]byte, 0, len(b)*138/100+1)
+	for x.Sign() > 0 {
+		x.QuoRem(x, bigRadix, mod)
+		out = append(out, alphabet[mod.Int64()])
+	}
+	// leading zero bytes are encoded as '1'
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// Decode decodes a base58 string
+func Decode(s string) ([]byte, error) {
+	x := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		d := decodeMap[s[i]]
+		if d < 0 {
+			return nil, ErrInvalidChar
+		}
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(d)))
+	}
+	var zeros int
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), x.Bytes()...), nil
+}
+
+// CheckEncode appends a 4 bytes double sha256 checksum to b and encodes it
+func CheckEncode(b []byte) string {
+	sum := checksum(b)
+	return Encode(append(append([]byte{}, b...), sum[:]...))
+}
+
+// CheckDecode decodes a base58check string and strips the checksum
+func CheckDecode(s string) ([]byte, error) {
+	b, err := Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 4 {
+		return nil, ErrTooShort
+	}
+	payload := b[:len(b)-4]
+	if sum := checksum(payload); string(sum[:]) != string(b[len(b)-4:]) {
+		return nil, ErrInvalidChecksum
+	}
+	return payload, nil
+}
+
+func checksum(b []byte) [4]byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	var sum [4]byte
+	copy(sum[:], second[:4])
+	return sum
+}
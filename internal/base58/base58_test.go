@@ -0,0 +1,60 @@
+package base58
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want string
+	}{
+		{"empty", "", ""},
+		{"leading zeros", "00000102", "115T"},
+		{"hello world", "68656c6c6f20776f726c64", "StV1DL6CwTryKyV"},
+		{"single byte", "ff", "5Q"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.hex)
+			if got := Encode(b); got != tt.want {
+				t.Errorf("Encode() = %v, want %v", got, tt.want)
+			}
+			got, err := Decode(tt.want)
+			if err != nil || hex.EncodeToString(got) != tt.hex {
+				t.Errorf("Decode() = %x, %v, want %v", got, err, tt.hex)
+			}
+		})
+	}
+}
+
+func TestCheckDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{"valid", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "00751e76e8199196d454941c45d1b3a323f1433bd6", nil},
+		{"invalid checksum", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", "", ErrInvalidChecksum},
+		{"invalid char", "0OIl", "", ErrInvalidChar},
+		{"too short", "1", "", ErrTooShort},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckDecode(tt.input)
+			if err != tt.wantErr {
+				t.Errorf("CheckDecode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("CheckDecode() = %x, want %v", got, tt.want)
+			}
+			if err == nil && CheckEncode(got) != tt.input {
+				t.Errorf("CheckEncode() = %v, want %v", CheckEncode(got), tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Package bech32 implements the BIP173 bech32 and BIP350 bech32m encodings.
+package bech32
+
+import (
+	"errors"
+	"strings"
+)
+
+// Charset is the bech32 alphabet
+const Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Variant is the checksum constant of an encoding
+type Variant uint32
+
+// Variant list
+const (
+	Bech32  Variant = 1
+	Bech32m Variant = 0x2bc830a3
+)
+
+// Error list
+var (
+	ErrMixedCase       = errors.New("bech32: mixed case")
+	ErrInvalidChar     = errors.New("bech32: invalid character")
+	ErrInvalidChecksum = errors.New("bech32: invalid checksum")
+	ErrInvalidLength   = errors.New("bech32: invalid length")
+	ErrInvalidPadding  = errors.New("bech32: invalid padding")
+)
+
+func polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+// Encode encodes 5-bit groups with the human readable part and checksum variant
+func Encode(hrp string, data []byte, v Variant) string {
+	hrp = strings.ToLower(hrp)
+	values := append(hrpExpand(hrp), data...)
+	mod := polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ uint32(v)
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + 6)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		sb.WriteByte(Charset[d])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(Charset[(mod>>uint(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+// Decode decodes a bech32 or bech32m string without any length limitation,
+// it returns the human readable part in lower case, the 5-bit groups and the variant
+func Decode(s string) (string, []byte, Variant, error) {
+	lower, upper := strings.ToLower(s), strings.ToUpper(s)
+	if s != lower && s != upper {
+		return "", nil, 0, ErrMixedCase
+	}
+	s = lower
+
+	pos := strings.LastIndexByte(s, '1')
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, 0, ErrInvalidLength
+	}
+	hrp := s[:pos]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, ErrInvalidChar
+		}
+	}
+
+	data := make([]byte, 0, len(s)-pos-1)
+	for i := pos + 1; i < len(s); i++ {
+		d := strings.IndexByte(Charset, s[i])
+		if d < 0 {
+			return "", nil, 0, ErrInvalidChar
+		}
+		data = append(data, byte(d))
+	}
+
+	switch Variant(polymod(append(hrpExpand(hrp), data...))) {
+	case Bech32:
+		return hrp, data[:len(data)-6], Bech32, nil
+	case Bech32m:
+		return hrp, data[:len(data)-6], Bech32m, nil
+	}
+	return "", nil, 0, ErrInvalidChecksum
+}
+
+// ConvertBits regroups bits from fromBits width to toBits width
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var acc uint32
+	var bits uint
+	maxv := uint32(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, d := range data {
+		if uint32(d)>>fromBits != 0 {
+			return nil, ErrInvalidChar
+		}
+		acc = acc<<fromBits | uint32(d)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, ErrInvalidPadding
+	}
+	return out, nil
+}
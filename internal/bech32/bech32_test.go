@@ -0,0 +1,65 @@
+package bech32
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		variant Variant
+		wantErr error
+	}{
+		{"bech32 upper", "A12UEL5L", Bech32, nil},
+		{"bech32 lower", "a12uel5l", Bech32, nil},
+		{"bech32 long hrp", "an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs", Bech32, nil},
+		{"bech32 data", "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", Bech32, nil},
+		{"bech32m upper", "A1LQFN3A", Bech32m, nil},
+		{"bech32m lower", "a1lqfn3a", Bech32m, nil},
+		{"bech32m data", "abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", Bech32m, nil},
+		{"mixed case", "A1lqfn3a", 0, ErrMixedCase},
+		{"no separator", "pzry9x0s0muk", 0, ErrInvalidLength},
+		{"empty hrp", "1pzry9x0s0muk", 0, ErrInvalidLength},
+		{"invalid data char", "x1b4n0q5v", 0, ErrInvalidChar},
+		{"invalid checksum", "a12uel5m", 0, ErrInvalidChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hrp, data, v, err := Decode(tt.input)
+			if err != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if v != tt.variant {
+				t.Errorf("Decode() variant = %v, want %v", v, tt.variant)
+			}
+			if got := Encode(hrp, data, v); got != strings.ToLower(tt.input) {
+				t.Errorf("Encode() = %v, want %v", got, strings.ToLower(tt.input))
+			}
+		})
+	}
+}
+
+func TestConvertBits(t *testing.T) {
+	input := []byte{0xff, 0x00, 0xab}
+	five, err := ConvertBits(input, 8, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ConvertBits(five, 5, 8, false)
+	if err != nil || !bytes.Equal(got, input) {
+		t.Errorf("ConvertBits() = %x, %v, want %x", got, err, input)
+	}
+	if _, err := ConvertBits([]byte{32}, 5, 8, false); err != ErrInvalidChar {
+		t.Errorf("ConvertBits() error = %v, want %v", err, ErrInvalidChar)
+	}
+	if _, err := ConvertBits([]byte{1}, 5, 8, false); err != ErrInvalidPadding {
+		t.Errorf("ConvertBits() error = %v, want %v", err, ErrInvalidPadding)
+	}
+}
@@ -0,0 +1,72 @@
+// Package cipherseed decodes the words of an LND aezeed cipher seed without
+// deciphering it, it is shared by the bip39 and the aezeed packages.
+package cipherseed
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"math/big"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/islishude/bip39/internal/wordlist"
+)
+
+// Version is the external version of the cipher seed
+const Version = 0
+
+const (
+	// WordCount is the number of mnemonic words
+	WordCount = 24
+	// Size is the length of the enciphered seed
+	Size = 33
+	// SumOffset is the offset of the CRC32-C checksum
+	SumOffset = Size - 4
+)
+
+// Castagnoli is the CRC32-C table of the checksum
+var Castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Error list
+var (
+	ErrWordCount        = errors.New("aezeed: mnemonic must be 24 words")
+	ErrUnknownWord      = errors.New("aezeed: word not in the English word list")
+	ErrIncorrectVersion = errors.New("aezeed: unsupported version")
+	ErrChecksum         = errors.New("aezeed: checksum mismatch")
+)
+
+var index = func() map[string]int {
+	m := make(map[string]int, len(wordlist.English))
+	for i, w := range wordlist.English {
+		m[w] = i
+	}
+	return m
+}()
+
+// Decode returns the enciphered seed bytes after checking the version and
+// the checksum, no passphrase is required
+func Decode(mnemonic string) ([]byte, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	if len(words) != WordCount {
+		return nil, ErrWordCount
+	}
+	n := new(big.Int)
+	for _, w := range words {
+		idx, ok := index[w]
+		if !ok {
+			return nil, ErrUnknownWord
+		}
+		n.Lsh(n, 11).Or(n, big.NewInt(int64(idx)))
+	}
+	// 24 words are 264 bits, exactly the 33 bytes
+	data := n.FillBytes(make([]byte, Size))
+	if data[0] != Version {
+		return nil, ErrIncorrectVersion
+	}
+	if crc32.Checksum(data[:SumOffset], Castagnoli) != binary.BigEndian.Uint32(data[SumOffset:]) {
+		return nil, ErrChecksum
+	}
+	return data, nil
+}
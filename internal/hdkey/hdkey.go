@@ -0,0 +1,314 @@
+// Package hdkey implements BIP32 hierarchical deterministic keys over secp256k1
+// and the hardened-only SLIP-0010 derivation for ed25519 and curve25519.
+package hdkey
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/islishude/bip39/internal/base58"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // required by bitcoin
+)
+
+// Hardened is the offset of hardened child indexes
+const Hardened uint32 = 0x80000000
+
+// Error list
+var (
+	ErrInvalidSeed     = errors.New("hdkey: invalid seed length")
+	ErrInvalidKey      = errors.New("hdkey: invalid extended key")
+	ErrInvalidChild    = errors.New("hdkey: invalid child key, try next index")
+	ErrHardenedPublic  = errors.New("hdkey: cannot derive hardened child from public key")
+	ErrInvalidPath     = errors.New("hdkey: invalid derivation path")
+	ErrUnknownVersion  = errors.New("hdkey: unknown extended key version")
+	ErrNotHardenedPath = errors.New("hdkey: slip10 path must be hardened only")
+)
+
+// Version is the 4 bytes prefix of a serialized extended key
+type Version [4]byte
+
+// Known versions of mainnet and testnet extended keys
+var (
+	VersionXprv = Version{0x04, 0x88, 0xad, 0xe4}
+	VersionXpub = Version{0x04, 0x88, 0xb2, 0x1e}
+	VersionYprv = Version{0x04, 0x9d, 0x78, 0x78}
+	VersionYpub = Version{0x04, 0x9d, 0x7c, 0xb2}
+	VersionZprv = Version{0x04, 0xb2, 0x43, 0x0c}
+	VersionZpub = Version{0x04, 0xb2, 0x47, 0x46}
+	VersionTprv = Version{0x04, 0x35, 0x83, 0x94}
+	VersionTpub = Version{0x04, 0x35, 0x87, 0xcf}
+	VersionUprv = Version{0x04, 0x4a, 0x4e, 0x28}
+	VersionUpub = Version{0x04, 0x4a, 0x52, 0x62}
+	VersionVprv = Version{0x04, 0x5f, 0x18, 0xbc}
+	VersionVpub = Version{0x04, 0x5f, 0x1c, 0xf6}
+)
+
+// private => public version mapping
+var versionPairs = map[Version]Version{
+	VersionXprv: VersionXpub,
+	VersionYprv: VersionYpub,
+	VersionZprv: VersionZpub,
+	VersionTprv: VersionTpub,
+	VersionUprv: VersionUpub,
+	VersionVprv: VersionVpub,
+}
+
+// Key is a BIP32 extended key
+type Key struct {
+	Version   Version
+	Depth     uint8
+	ParentFP  [4]byte
+	ChildNum  uint32
+	ChainCode [32]byte
+
+	// 32 bytes private key or 33 bytes compressed public key
+	key     []byte
+	private bool
+}
+
+// NewMaster creates the master extended private key from a seed
+func NewMaster(seed []byte) (*Key, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeed
+	}
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	_, _ = mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	var k secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(sum[:32]); overflow || k.IsZero() {
+		return nil, ErrInvalidSeed
+	}
+	master := &Key{Version: VersionXprv, key: sum[:32], private: true}
+	copy(master.ChainCode[:], sum[32:])
+	return master, nil
+}
+
+// Parse parses a base58check serialized extended key
+func Parse(s string) (*Key, error) {
+	b, err := base58.CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 78 {
+		return nil, ErrInvalidKey
+	}
+	k := &Key{Depth: b[4], ChildNum: binary.BigEndian.Uint32(b[9:13])}
+	copy(k.Version[:], b[:4])
+	copy(k.ParentFP[:], b[5:9])
+	copy(k.ChainCode[:], b[13:45])
+
+	switch b[45] {
+	case 0:
+		var s secp256k1.ModNScalar
+		if overflow := s.SetByteSlice(b[46:]); overflow || s.IsZero() {
+			return nil, ErrInvalidKey
+		}
+		k.key, k.private = b[46:], true
+	case 2, 3:
+		if _, err := secp256k1.ParsePubKey(b[45:]); err != nil {
+			return nil, ErrInvalidKey
+		}
+		k.key = b[45:]
+	default:
+		return nil, ErrInvalidKey
+	}
+	return k, nil
+}
+
+// IsPrivate reports whether k holds a private key
+func (k *Key) IsPrivate() bool {
+	return k.private
+}
+
+// PrivateKey returns 32 bytes private key or nil for public extended key
+func (k *Key) PrivateKey() []byte {
+	if !k.private {
+		return nil
+	}
+	return append([]byte{}, k.key...)
+}
+
+// PublicKey returns 33 bytes compressed public key
+func (k *Key) PublicKey() []byte {
+	if !k.private {
+		return append([]byte{}, k.key...)
+	}
+	return secp256k1.PrivKeyFromBytes(k.key).PubKey().SerializeCompressed()
+}
+
+// Fingerprint returns the first 4 bytes of the hash160 of the public key
+func (k *Key) Fingerprint() [4]byte {
+	var fp [4]byte
+	copy(fp[:], Hash160(k.PublicKey()))
+	return fp
+}
+
+// Neuter returns the public extended key of k
+func (k *Key) Neuter() *Key {
+	if !k.private {
+		return k
+	}
+	pub := *k
+	pub.key, pub.private = k.PublicKey(), false
+	if v, ok := versionPairs[k.Version]; ok {
+		pub.Version = v
+	}
+	return &pub
+}
+
+// WithVersion returns a copy of k with another serialization version
+func (k *Key) WithVersion(v Version) *Key {
+	c := *k
+	c.Version = v
+	return &c
+}
+
+// Child derives the child key at index i
+func (k *Key) Child(i uint32) (*Key, error) {
+	data := make([]byte, 0, 37)
+	if i >= Hardened {
+		if !k.private {
+			return nil, ErrHardenedPublic
+		}
+		data = append(append(data, 0), k.key...)
+	} else {
+		data = append(data, k.PublicKey()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+
+	mac := hmac.New(sha512.New, k.ChainCode[:])
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	var il secp256k1.ModNScalar
+	if overflow := il.SetByteSlice(sum[:32]); overflow {
+		return nil, ErrInvalidChild
+	}
+
+	child := &Key{
+		Version:  k.Version,
+		Depth:    k.Depth + 1,
+		ParentFP: k.Fingerprint(),
+		ChildNum: i,
+		private:  k.private,
+	}
+	copy(child.ChainCode[:], sum[32:])
+
+	if k.private {
+		var parent secp256k1.ModNScalar
+		parent.SetByteSlice(k.key)
+		il.Add(&parent)
+		if il.IsZero() {
+			return nil, ErrInvalidChild
+		}
+		b := il.Bytes()
+		child.key = b[:]
+		return child, nil
+	}
+
+	pub, err := secp256k1.ParsePubKey(k.key)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	var point, tweak secp256k1.JacobianPoint
+	pub.AsJacobian(&point)
+	secp256k1.ScalarBaseMultNonConst(&il, &tweak)
+	secp256k1.AddNonConst(&tweak, &point, &point)
+	if (point.X.IsZero() && point.Y.IsZero()) || point.Z.IsZero() {
+		return nil, ErrInvalidChild
+	}
+	point.ToAffine()
+	child.key = secp256k1.NewPublicKey(&point.X, &point.Y).SerializeCompressed()
+	return child, nil
+}
+
+// Derive derives the descendant key along the path
+func (k *Key) Derive(path []uint32) (*Key, error) {
+	var err error
+	for _, i := range path {
+		if k, err = k.Child(i); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// DerivePath derives the descendant key along a path string like m/44'/0'/0'
+func (k *Key) DerivePath(path string) (*Key, error) {
+	p, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return k.Derive(p)
+}
+
+// String returns the base58check serialized extended key
+func (k *Key) String() string {
+	b := make([]byte, 0, 78)
+	b = append(b, k.Version[:]...)
+	b = append(b, k.Depth)
+	b = append(b, k.ParentFP[:]...)
+	b = binary.BigEndian.AppendUint32(b, k.ChildNum)
+	b = append(b, k.ChainCode[:]...)
+	if k.private {
+		b = append(b, 0)
+	}
+	b = append(b, k.key...)
+	return base58.CheckEncode(b)
+}
+
+// ParsePath parses a path like m/44'/0'/0'/0/1, both ' and h mark hardened indexes
+func ParsePath(path string) ([]uint32, error) {
+	path = strings.TrimSpace(path)
+	if path == "" || path == "m" || path == "M" {
+		return []uint32{}, nil
+	}
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "m/"), "M/")
+
+	parts := strings.Split(path, "/")
+	res := make([]uint32, 0, len(parts))
+	for _, part := range parts {
+		var offset uint32
+		if n := len(part); n > 0 && (part[n-1] == '\'' || part[n-1] == 'h' || part[n-1] == 'H') {
+			part, offset = part[:n-1], Hardened
+		}
+		idx, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(idx) >= Hardened {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+		}
+		res = append(res, uint32(idx)+offset)
+	}
+	return res, nil
+}
+
+// FormatPath formats a path with the m prefix and ' as the hardened marker
+func FormatPath(path []uint32) string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, i := range path {
+		sb.WriteByte('/')
+		if i >= Hardened {
+			sb.WriteString(strconv.FormatUint(uint64(i-Hardened), 10))
+			sb.WriteByte('\'')
+		} else {
+			sb.WriteString(strconv.FormatUint(uint64(i), 10))
+		}
+	}
+	return sb.String()
+}
+
+// Hash160 returns ripemd160(sha256(b))
+func Hash160(b []byte) []byte {
+	sum := sha256.Sum256(b)
+	h := ripemd160.New()
+	_, _ = h.Write(sum[:])
+	return h.Sum(nil)
+}
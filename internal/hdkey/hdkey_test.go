@@ -0,0 +1,108 @@
+package hdkey
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+// BIP32 test vector 1
+const vector1Seed = "000102030405060708090a0b0c0d0e0f"
+
+func TestKey_DerivePath(t *testing.T) {
+	seed, _ := hex.DecodeString(vector1Seed)
+	master, err := NewMaster(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		xprv string
+		xpub string
+	}{
+		{
+			path: "m",
+			xprv: "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			xpub: "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+		},
+		{
+			path: "m/0'",
+			xprv: "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7",
+			xpub: "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+		},
+		{
+			path: "m/0'/1/2'/2/1000000000",
+			xprv: "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76",
+			xpub: "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := master.DerivePath(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.xprv {
+				t.Errorf("DerivePath() = %v, want %v", got, tt.xprv)
+			}
+			if got.Neuter().String() != tt.xpub {
+				t.Errorf("Neuter() = %v, want %v", got.Neuter(), tt.xpub)
+			}
+			parsed, err := Parse(tt.xprv)
+			if err != nil || !reflect.DeepEqual(parsed, got) {
+				t.Errorf("Parse() = %v, %v, want %v", parsed, err, got)
+			}
+		})
+	}
+}
+
+func TestKey_PublicChild(t *testing.T) {
+	seed, _ := hex.DecodeString(vector1Seed)
+	master, _ := NewMaster(seed)
+	parent, _ := master.DerivePath("m/0'/1/2'")
+
+	got, err := parent.Neuter().DerivePath("m/2/1000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy"
+	if got.String() != want {
+		t.Errorf("DerivePath() = %v, want %v", got, want)
+	}
+	if _, err := parent.Neuter().Child(Hardened); err != ErrHardenedPublic {
+		t.Errorf("Child() error = %v, want %v", err, ErrHardenedPublic)
+	}
+	if fp := master.Fingerprint(); hex.EncodeToString(fp[:]) != "3442193e" {
+		t.Errorf("Fingerprint() = %x, want 3442193e", fp)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []uint32
+		wantErr bool
+	}{
+		{"m", []uint32{}, false},
+		{"m/44'/0h/0H/1/2", []uint32{44 + Hardened, Hardened, Hardened, 1, 2}, false},
+		{"84'/0'", []uint32{84 + Hardened, Hardened}, false},
+		{"m/x", nil, true},
+		{"m/2147483648", nil, true},
+		{"m//1", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := ParsePath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := FormatPath([]uint32{44 + Hardened, 0, 1}); got != "m/44'/0/1" {
+		t.Errorf("FormatPath() = %v, want m/44'/0/1", got)
+	}
+}
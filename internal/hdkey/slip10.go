@@ -0,0 +1,40 @@
+package hdkey
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+)
+
+// SLIP-0010 curve seed keys
+const (
+	Ed25519Seed    = "ed25519 seed"
+	Curve25519Seed = "curve25519 seed"
+)
+
+// DeriveSLIP10 derives 32 bytes private key and chain code along a hardened-only path
+// for the ed25519 or curve25519 curve
+func DeriveSLIP10(curve string, seed []byte, path []uint32) (key, chainCode []byte, err error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, nil, ErrInvalidSeed
+	}
+	mac := hmac.New(sha512.New, []byte(curve))
+	_, _ = mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode = sum[:32], sum[32:]
+
+	for _, i := range path {
+		if i < Hardened {
+			return nil, nil, ErrNotHardenedPath
+		}
+		data := make([]byte, 0, 37)
+		data = append(append(data, 0), key...)
+		data = binary.BigEndian.AppendUint32(data, i)
+
+		mac = hmac.New(sha512.New, chainCode)
+		_, _ = mac.Write(data)
+		sum = mac.Sum(nil)
+		key, chainCode = sum[:32], sum[32:]
+	}
+	return key, chainCode, nil
+}
@@ -0,0 +1,36 @@
+package hdkey
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestDeriveSLIP10(t *testing.T) {
+	seed, _ := hex.DecodeString(vector1Seed)
+	tests := []struct {
+		name    string
+		curve   string
+		path    string
+		want    string
+		wantErr error
+	}{
+		{"ed25519 m", Ed25519Seed, "m", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", nil},
+		{"ed25519 m/0'", Ed25519Seed, "m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", nil},
+		{"ed25519 m/0'/1'/2'", Ed25519Seed, "m/0'/1'/2'", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9", nil},
+		{"curve25519 m/0'", Curve25519Seed, "m/0'", "cd7630d7513cbe80515f7317cdb9a47ad4a56b63c3f1dc29583ab8d4cc25a9b2", nil},
+		{"not hardened", Ed25519Seed, "m/0", "", ErrNotHardenedPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, _ := ParsePath(tt.path)
+			key, _, err := DeriveSLIP10(tt.curve, seed, path)
+			if err != tt.wantErr {
+				t.Errorf("DeriveSLIP10() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got := hex.EncodeToString(key); got != tt.want {
+				t.Errorf("DeriveSLIP10() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,2055 @@
+package wordlist
+
+// Pinyin are the toneless Hanyu Pinyin readings of the characters of
+// ChineseSimplified and ChineseTraditional, which are index aligned. The most
+// common reading goes first, ü is written v as in input methods.
+var Pinyin = []string{
+	"de di",         // 的
+	"yi",            // 一
+	"shi",           // 是
+	"zai",           // 在
+	"bu",            // 不
+	"le liao",       // 了
+	"you",           // 有
+	"he huo",        // 和
+	"ren",           // 人
+	"zhe",           // 这
+	"zhong",         // 中
+	"da dai",        // 大
+	"wei",           // 为
+	"shang",         // 上
+	"ge",            // 个
+	"guo",           // 国
+	"wo",            // 我
+	"yi",            // 以
+	"yao",           // 要
+	"ta",            // 他
+	"shi",           // 时
+	"lai",           // 来
+	"yong",          // 用
+	"men",           // 们
+	"sheng",         // 生
+	"dao",           // 到
+	"zuo",           // 作
+	"di de",         // 地
+	"yu",            // 于
+	"chu",           // 出
+	"jiu",           // 就
+	"fen",           // 分
+	"dui",           // 对
+	"cheng",         // 成
+	"hui kuai",      // 会
+	"ke",            // 可
+	"zhu",           // 主
+	"fa",            // 发
+	"nian",          // 年
+	"dong",          // 动
+	"tong",          // 同
+	"gong",          // 工
+	"ye",            // 也
+	"neng",          // 能
+	"xia",           // 下
+	"guo",           // 过
+	"zi",            // 子
+	"shuo",          // 说
+	"chan",          // 产
+	"zhong",         // 种
+	"mian",          // 面
+	"er",            // 而
+	"fang",          // 方
+	"hou",           // 后
+	"duo",           // 多
+	"ding",          // 定
+	"xing hang",     // 行
+	"xue",           // 学
+	"fa",            // 法
+	"suo",           // 所
+	"min",           // 民
+	"de dei",        // 得
+	"jing",          // 经
+	"shi",           // 十
+	"san",           // 三
+	"zhi",           // 之
+	"jin",           // 进
+	"zhe zhao zhuo", // 着
+	"deng",          // 等
+	"bu",            // 部
+	"du duo",        // 度
+	"jia",           // 家
+	"dian",          // 电
+	"li",            // 力
+	"li",            // 里
+	"ru",            // 如
+	"shui",          // 水
+	"hua",           // 化
+	"gao",           // 高
+	"zi",            // 自
+	"er",            // 二
+	"li",            // 理
+	"qi",            // 起
+	"xiao",          // 小
+	"wu",            // 物
+	"xian",          // 现
+	"shi",           // 实
+	"jia",           // 加
+	"liang",         // 量
+	"dou du",        // 都
+	"liang",         // 两
+	"ti",            // 体
+	"zhi",           // 制
+	"ji",            // 机
+	"dang",          // 当
+	"shi",           // 使
+	"dian",          // 点
+	"cong",          // 从
+	"ye",            // 业
+	"ben",           // 本
+	"qu",            // 去
+	"ba",            // 把
+	"xing",          // 性
+	"hao",           // 好
+	"ying",          // 应
+	"kai",           // 开
+	"ta",            // 它
+	"he",            // 合
+	"hai huan",      // 还
+	"yin",           // 因
+	"you",           // 由
+	"qi",            // 其
+	"xie",           // 些
+	"ran",           // 然
+	"qian",          // 前
+	"wai",           // 外
+	"tian",          // 天
+	"zheng",         // 政
+	"si",            // 四
+	"ri",            // 日
+	"na",            // 那
+	"she",           // 社
+	"yi",            // 义
+	"shi",           // 事
+	"ping",          // 平
+	"xing",          // 形
+	"xiang",         // 相
+	"quan",          // 全
+	"biao",          // 表
+	"jian",          // 间
+	"yang",          // 样
+	"yu",            // 与
+	"guan",          // 关
+	"ge",            // 各
+	"zhong chong",   // 重
+	"xin",           // 新
+	"xian",          // 线
+	"nei",           // 内
+	"shu",           // 数
+	"zheng",         // 正
+	"xin",           // 心
+	"fan",           // 反
+	"ni",            // 你
+	"ming",          // 明
+	"kan",           // 看
+	"yuan",          // 原
+	"you",           // 又
+	"me",            // 么
+	"li",            // 利
+	"bi",            // 比
+	"huo",           // 或
+	"dan",           // 但
+	"zhi",           // 质
+	"qi",            // 气
+	"di",            // 第
+	"xiang",         // 向
+	"dao",           // 道
+	"ming",          // 命
+	"ci",            // 此
+	"bian",          // 变
+	"tiao",          // 条
+	"zhi",           // 只
+	"mei mo",        // 没
+	"jie",           // 结
+	"jie xie",       // 解
+	"wen",           // 问
+	"yi",            // 意
+	"jian",          // 建
+	"yue",           // 月
+	"gong",          // 公
+	"wu",            // 无
+	"xi ji",         // 系
+	"jun",           // 军
+	"hen",           // 很
+	"qing",          // 情
+	"zhe",           // 者
+	"zui",           // 最
+	"li",            // 立
+	"dai",           // 代
+	"xiang",         // 想
+	"yi",            // 已
+	"tong",          // 通
+	"bing",          // 并
+	"ti",            // 提
+	"zhi",           // 直
+	"ti",            // 题
+	"dang",          // 党
+	"cheng",         // 程
+	"zhan",          // 展
+	"wu",            // 五
+	"guo",           // 果
+	"liao",          // 料
+	"xiang",         // 象
+	"yuan",          // 员
+	"ge",            // 革
+	"wei",           // 位
+	"ru",            // 入
+	"chang",         // 常
+	"wen",           // 文
+	"zong",          // 总
+	"ci",            // 次
+	"pin",           // 品
+	"shi",           // 式
+	"huo",           // 活
+	"she",           // 设
+	"ji",            // 及
+	"guan",          // 管
+	"te",            // 特
+	"jian",          // 件
+	"chang zhang",   // 长
+	"qiu",           // 求
+	"lao",           // 老
+	"tou",           // 头
+	"ji",            // 基
+	"zi",            // 资
+	"bian",          // 边
+	"liu",           // 流
+	"lu",            // 路
+	"ji",            // 级
+	"shao",          // 少
+	"tu",            // 图
+	"shan",          // 山
+	"tong",          // 统
+	"jie",           // 接
+	"zhi",           // 知
+	"jiao",          // 较
+	"jiang qiang",   // 将
+	"zu",            // 组
+	"jian",          // 见
+	"ji",            // 计
+	"bie",           // 别
+	"ta",            // 她
+	"shou",          // 手
+	"jiao jue",      // 角
+	"qi",            // 期
+	"gen",           // 根
+	"lun",           // 论
+	"yun",           // 运
+	"nong",          // 农
+	"zhi",           // 指
+	"ji",            // 几
+	"jiu",           // 九
+	"qu",            // 区
+	"qiang jiang",   // 强
+	"fang",          // 放
+	"jue",           // 决
+	"xi",            // 西
+	"bei",           // 被
+	"gan",           // 干
+	"zuo",           // 做
+	"bi",            // 必
+	"zhan",          // 战
+	"xian",          // 先
+	"hui",           // 回
+	"ze",            // 则
+	"ren",           // 任
+	"qu",            // 取
+	"ju",            // 据
+	"chu",           // 处
+	"dui",           // 队
+	"nan",           // 南
+	"gei ji",        // 给
+	"se shai",       // 色
+	"guang",         // 光
+	"men",           // 门
+	"ji",            // 即
+	"bao",           // 保
+	"zhi",           // 治
+	"bei",           // 北
+	"zao",           // 造
+	"bai",           // 百
+	"gui",           // 规
+	"re",            // 热
+	"ling",          // 领
+	"qi",            // 七
+	"hai",           // 海
+	"kou",           // 口
+	"dong",          // 东
+	"dao",           // 导
+	"qi",            // 器
+	"ya",            // 压
+	"zhi",           // 志
+	"shi",           // 世
+	"jin",           // 金
+	"zeng",          // 增
+	"zheng",         // 争
+	"ji",            // 济
+	"jie",           // 阶
+	"you",           // 油
+	"si",            // 思
+	"shu",           // 术
+	"ji",            // 极
+	"jiao",          // 交
+	"shou",          // 受
+	"lian",          // 联
+	"shen shi",      // 什
+	"ren",           // 认
+	"liu",           // 六
+	"gong",          // 共
+	"quan",          // 权
+	"shou",          // 收
+	"zheng",         // 证
+	"gai",           // 改
+	"qing",          // 清
+	"mei",           // 美
+	"zai",           // 再
+	"cai",           // 采
+	"zhuan",         // 转
+	"geng",          // 更
+	"dan",           // 单
+	"feng",          // 风
+	"qie",           // 切
+	"da",            // 打
+	"bai",           // 白
+	"jiao",          // 教
+	"su",            // 速
+	"hua",           // 花
+	"dai",           // 带
+	"an",            // 安
+	"chang",         // 场
+	"shen",          // 身
+	"che ju",        // 车
+	"li",            // 例
+	"zhen",          // 真
+	"wu",            // 务
+	"ju",            // 具
+	"wan",           // 万
+	"mei",           // 每
+	"mu",            // 目
+	"zhi",           // 至
+	"da",            // 达
+	"zou",           // 走
+	"ji",            // 积
+	"shi",           // 示
+	"yi",            // 议
+	"sheng",         // 声
+	"bao",           // 报
+	"dou",           // 斗
+	"wan",           // 完
+	"lei",           // 类
+	"ba",            // 八
+	"li",            // 离
+	"hua",           // 华
+	"ming",          // 名
+	"que",           // 确
+	"cai",           // 才
+	"ke",            // 科
+	"zhang",         // 张
+	"xin",           // 信
+	"ma",            // 马
+	"jie",           // 节
+	"hua",           // 话
+	"mi",            // 米
+	"zheng",         // 整
+	"kong",          // 空
+	"yuan",          // 元
+	"kuang",         // 况
+	"jin",           // 今
+	"ji",            // 集
+	"wen",           // 温
+	"chuan zhuan",   // 传
+	"tu",            // 土
+	"xu",            // 许
+	"bu",            // 步
+	"qun",           // 群
+	"guang",         // 广
+	"shi dan",       // 石
+	"ji",            // 记
+	"xu",            // 需
+	"duan",          // 段
+	"yan",           // 研
+	"jie",           // 界
+	"la",            // 拉
+	"lin",           // 林
+	"lv",            // 律
+	"jiao",          // 叫
+	"qie",           // 且
+	"jiu",           // 究
+	"guan",          // 观
+	"yue",           // 越
+	"zhi",           // 织
+	"zhuang",        // 装
+	"ying",          // 影
+	"suan",          // 算
+	"di",            // 低
+	"chi",           // 持
+	"yin",           // 音
+	"zhong",         // 众
+	"shu",           // 书
+	"bu",            // 布
+	"fu",            // 复
+	"rong",          // 容
+	"er",            // 儿
+	"xu",            // 须
+	"ji",            // 际
+	"shang",         // 商
+	"fei",           // 非
+	"yan",           // 验
+	"lian",          // 连
+	"duan",          // 断
+	"shen",          // 深
+	"nan",           // 难
+	"jin",           // 近
+	"kuang",         // 矿
+	"qian",          // 千
+	"zhou",          // 周
+	"wei",           // 委
+	"su",            // 素
+	"ji",            // 技
+	"bei",           // 备
+	"ban",           // 半
+	"ban",           // 办
+	"qing",          // 青
+	"sheng xing",    // 省
+	"lie",           // 列
+	"xi",            // 习
+	"xiang",         // 响
+	"yue",           // 约
+	"zhi",           // 支
+	"ban",           // 般
+	"shi",           // 史
+	"gan",           // 感
+	"lao",           // 劳
+	"bian pian",     // 便
+	"tuan",          // 团
+	"wang",          // 往
+	"suan",          // 酸
+	"li",            // 历
+	"shi",           // 市
+	"ke",            // 克
+	"he",            // 何
+	"chu",           // 除
+	"xiao",          // 消
+	"gou",           // 构
+	"fu",            // 府
+	"cheng chen",    // 称
+	"tai",           // 太
+	"zhun",          // 准
+	"jing",          // 精
+	"zhi",           // 值
+	"hao",           // 号
+	"lv shuai",      // 率
+	"zu",            // 族
+	"wei",           // 维
+	"hua",           // 划
+	"xuan",          // 选
+	"biao",          // 标
+	"xie",           // 写
+	"cun",           // 存
+	"hou",           // 候
+	"mao",           // 毛
+	"qin",           // 亲
+	"kuai",          // 快
+	"xiao",          // 效
+	"si",            // 斯
+	"yuan",          // 院
+	"cha zha",       // 查
+	"jiang",         // 江
+	"xing",          // 型
+	"yan",           // 眼
+	"wang",          // 王
+	"an",            // 按
+	"ge",            // 格
+	"yang",          // 养
+	"yi",            // 易
+	"zhi",           // 置
+	"pai",           // 派
+	"ceng",          // 层
+	"pian",          // 片
+	"shi",           // 始
+	"que",           // 却
+	"zhuan",         // 专
+	"zhuang",        // 状
+	"yu",            // 育
+	"chang",         // 厂
+	"jing",          // 京
+	"shi zhi",       // 识
+	"shi",           // 适
+	"shu zhu",       // 属
+	"yuan",          // 圆
+	"bao",           // 包
+	"huo",           // 火
+	"zhu",           // 住
+	"diao tiao",     // 调
+	"man",           // 满
+	"xian",          // 县
+	"ju",            // 局
+	"zhao",          // 照
+	"can shen cen",  // 参
+	"hong",          // 红
+	"xi",            // 细
+	"yin",           // 引
+	"ting",          // 听
+	"gai",           // 该
+	"tie",           // 铁
+	"jia",           // 价
+	"yan",           // 严
+	"shou",          // 首
+	"di",            // 底
+	"ye",            // 液
+	"guan",          // 官
+	"de",            // 德
+	"sui",           // 随
+	"bing",          // 病
+	"su",            // 苏
+	"shi",           // 失
+	"er",            // 尔
+	"si",            // 死
+	"jiang",         // 讲
+	"pei",           // 配
+	"nv",            // 女
+	"huang",         // 黄
+	"tui",           // 推
+	"xian",          // 显
+	"tan",           // 谈
+	"zui",           // 罪
+	"shen",          // 神
+	"yi",            // 艺
+	"ne ni",         // 呢
+	"xi",            // 席
+	"han",           // 含
+	"qi",            // 企
+	"wang",          // 望
+	"mi",            // 密
+	"pi",            // 批
+	"ying",          // 营
+	"xiang",         // 项
+	"fang",          // 防
+	"ju",            // 举
+	"qiu",           // 球
+	"ying",          // 英
+	"yang",          // 氧
+	"shi",           // 势
+	"gao",           // 告
+	"li",            // 李
+	"tai",           // 台
+	"luo la lao",    // 落
+	"mu",            // 木
+	"bang",          // 帮
+	"lun",           // 轮
+	"po",            // 破
+	"ya",            // 亚
+	"shi",           // 师
+	"wei",           // 围
+	"zhu",           // 注
+	"yuan",          // 远
+	"zi",            // 字
+	"cai",           // 材
+	"pai",           // 排
+	"gong",          // 供
+	"he",            // 河
+	"tai",           // 态
+	"feng",          // 封
+	"ling",          // 另
+	"shi",           // 施
+	"jian",          // 减
+	"shu",           // 树
+	"rong",          // 溶
+	"zen",           // 怎
+	"zhi",           // 止
+	"an",            // 案
+	"yan",           // 言
+	"shi",           // 士
+	"jun",           // 均
+	"wu",            // 武
+	"gu",            // 固
+	"ye",            // 叶
+	"yu",            // 鱼
+	"bo",            // 波
+	"shi",           // 视
+	"jin",           // 仅
+	"fei",           // 费
+	"jin",           // 紧
+	"ai",            // 爱
+	"zuo",           // 左
+	"zhang",         // 章
+	"zao",           // 早
+	"chao zhao",     // 朝
+	"hai",           // 害
+	"xu",            // 续
+	"qing",          // 轻
+	"fu",            // 服
+	"shi",           // 试
+	"shi",           // 食
+	"chong",         // 充
+	"bing",          // 兵
+	"yuan",          // 源
+	"pan",           // 判
+	"hu",            // 护
+	"si",            // 司
+	"zu",            // 足
+	"mou",           // 某
+	"lian",          // 练
+	"cha chai ci",   // 差
+	"zhi",           // 致
+	"ban",           // 板
+	"tian",          // 田
+	"jiang xiang",   // 降
+	"hei",           // 黑
+	"fan",           // 犯
+	"fu",            // 负
+	"ji",            // 击
+	"fan",           // 范
+	"ji",            // 继
+	"xing",          // 兴
+	"si shi",        // 似
+	"yu",            // 余
+	"jian",          // 坚
+	"qu",            // 曲
+	"shu",           // 输
+	"xiu",           // 修
+	"gu",            // 故
+	"cheng",         // 城
+	"fu",            // 夫
+	"gou",           // 够
+	"song",          // 送
+	"bi",            // 笔
+	"chuan",         // 船
+	"zhan",          // 占
+	"you",           // 右
+	"cai",           // 财
+	"chi",           // 吃
+	"fu",            // 富
+	"chun",          // 春
+	"zhi",           // 职
+	"jue jiao",      // 觉
+	"han",           // 汉
+	"hua",           // 画
+	"gong",          // 功
+	"ba",            // 巴
+	"gen",           // 跟
+	"sui",           // 虽
+	"za",            // 杂
+	"fei",           // 飞
+	"jian",          // 检
+	"xi",            // 吸
+	"zhu",           // 助
+	"sheng",         // 升
+	"yang",          // 阳
+	"hu",            // 互
+	"chu",           // 初
+	"chuang",        // 创
+	"kang",          // 抗
+	"kao",           // 考
+	"tou",           // 投
+	"huai",          // 坏
+	"ce",            // 策
+	"gu",            // 古
+	"jing",          // 径
+	"huan",          // 换
+	"wei",           // 未
+	"pao",           // 跑
+	"liu",           // 留
+	"gang",          // 钢
+	"ceng zeng",     // 曾
+	"duan",          // 端
+	"ze",            // 责
+	"zhan",          // 站
+	"jian",          // 简
+	"shu",           // 述
+	"qian",          // 钱
+	"fu",            // 副
+	"jin",           // 尽
+	"di",            // 帝
+	"she",           // 射
+	"cao",           // 草
+	"chong",         // 冲
+	"cheng",         // 承
+	"du",            // 独
+	"ling",          // 令
+	"xian",          // 限
+	"a e",           // 阿
+	"xuan",          // 宣
+	"huan",          // 环
+	"shuang",        // 双
+	"qing",          // 请
+	"chao",          // 超
+	"wei",           // 微
+	"rang",          // 让
+	"kong",          // 控
+	"zhou",          // 州
+	"liang",         // 良
+	"zhou",          // 轴
+	"zhao",          // 找
+	"fou",           // 否
+	"ji",            // 纪
+	"yi",            // 益
+	"yi",            // 依
+	"you",           // 优
+	"ding",          // 顶
+	"chu",           // 础
+	"zai",           // 载
+	"dao",           // 倒
+	"fang",          // 房
+	"tu",            // 突
+	"zuo",           // 坐
+	"fen",           // 粉
+	"di",            // 敌
+	"lve lue",       // 略
+	"ke",            // 客
+	"yuan",          // 袁
+	"leng",          // 冷
+	"sheng",         // 胜
+	"jue",           // 绝
+	"xi",            // 析
+	"kuai",          // 块
+	"ji",            // 剂
+	"ce",            // 测
+	"si",            // 丝
+	"xie",           // 协
+	"su",            // 诉
+	"nian",          // 念
+	"chen",          // 陈
+	"reng",          // 仍
+	"luo",           // 罗
+	"yan",           // 盐
+	"you",           // 友
+	"yang",          // 洋
+	"cuo",           // 错
+	"ku",            // 苦
+	"ye",            // 夜
+	"xing",          // 刑
+	"yi",            // 移
+	"pin",           // 频
+	"zhu",           // 逐
+	"kao",           // 靠
+	"hun",           // 混
+	"mu",            // 母
+	"duan",          // 短
+	"pi",            // 皮
+	"zhong",         // 终
+	"ju",            // 聚
+	"qi",            // 汽
+	"cun",           // 村
+	"yun",           // 云
+	"na",            // 哪
+	"ji",            // 既
+	"ju",            // 距
+	"wei",           // 卫
+	"ting",          // 停
+	"lie",           // 烈
+	"yang",          // 央
+	"cha",           // 察
+	"shao",          // 烧
+	"xun",           // 迅
+	"jing",          // 境
+	"ruo",           // 若
+	"yin",           // 印
+	"zhou",          // 洲
+	"ke",            // 刻
+	"kuo",           // 括
+	"ji",            // 激
+	"kong",          // 孔
+	"gao",           // 搞
+	"shen",          // 甚
+	"shi",           // 室
+	"dai",           // 待
+	"he hu",         // 核
+	"xiao jiao",     // 校
+	"san",           // 散
+	"qin",           // 侵
+	"ba",            // 吧
+	"jia",           // 甲
+	"you",           // 游
+	"jiu",           // 久
+	"cai",           // 菜
+	"wei",           // 味
+	"jiu",           // 旧
+	"mo mu",         // 模
+	"hu",            // 湖
+	"huo",           // 货
+	"sun",           // 损
+	"yu",            // 预
+	"zu",            // 阻
+	"hao",           // 毫
+	"pu",            // 普
+	"wen",           // 稳
+	"yi",            // 乙
+	"ma",            // 妈
+	"zhi",           // 植
+	"xi",            // 息
+	"kuo",           // 扩
+	"yin",           // 银
+	"yu",            // 语
+	"hui",           // 挥
+	"jiu",           // 酒
+	"shou",          // 守
+	"na",            // 拿
+	"xu",            // 序
+	"zhi",           // 纸
+	"yi",            // 医
+	"que",           // 缺
+	"yu",            // 雨
+	"ma",            // 吗
+	"zhen",          // 针
+	"liu",           // 刘
+	"a",             // 啊
+	"ji",            // 急
+	"chang",         // 唱
+	"wu",            // 误
+	"xun",           // 训
+	"yuan",          // 愿
+	"shen",          // 审
+	"fu",            // 附
+	"huo",           // 获
+	"cha",           // 茶
+	"xian",          // 鲜
+	"liang",         // 粮
+	"jin",           // 斤
+	"hai",           // 孩
+	"tuo",           // 脱
+	"liu",           // 硫
+	"fei",           // 肥
+	"shan",          // 善
+	"long",          // 龙
+	"yan",           // 演
+	"fu",            // 父
+	"jian",          // 渐
+	"xue xie",       // 血
+	"huan",          // 欢
+	"xie",           // 械
+	"zhang",         // 掌
+	"ge",            // 歌
+	"sha",           // 沙
+	"gang",          // 刚
+	"gong",          // 攻
+	"wei",           // 谓
+	"dun",           // 盾
+	"tao",           // 讨
+	"wan",           // 晚
+	"li",            // 粒
+	"luan",          // 乱
+	"ran",           // 燃
+	"mao",           // 矛
+	"hu",            // 乎
+	"sha",           // 杀
+	"yao",           // 药
+	"ning",          // 宁
+	"lu",            // 鲁
+	"gui",           // 贵
+	"zhong",         // 钟
+	"mei",           // 煤
+	"du",            // 读
+	"ban",           // 班
+	"bo",            // 伯
+	"xiang",         // 香
+	"jie",           // 介
+	"po pai",        // 迫
+	"ju",            // 句
+	"feng",          // 丰
+	"pei",           // 培
+	"wo",            // 握
+	"lan",           // 兰
+	"dan",           // 担
+	"xian",          // 弦
+	"dan",           // 蛋
+	"chen",          // 沉
+	"jia",           // 假
+	"chuan",         // 穿
+	"zhi",           // 执
+	"da",            // 答
+	"le yue",        // 乐
+	"shui shei",     // 谁
+	"shun",          // 顺
+	"yan",           // 烟
+	"suo",           // 缩
+	"zheng",         // 征
+	"lian",          // 脸
+	"xi",            // 喜
+	"song",          // 松
+	"jiao",          // 脚
+	"kun",           // 困
+	"yi",            // 异
+	"mian",          // 免
+	"bei",           // 背
+	"xing",          // 星
+	"fu",            // 福
+	"mai",           // 买
+	"ran",           // 染
+	"jing",          // 井
+	"gai",           // 概
+	"man",           // 慢
+	"pa",            // 怕
+	"ci",            // 磁
+	"bei",           // 倍
+	"zu",            // 祖
+	"huang",         // 皇
+	"cu",            // 促
+	"jing",          // 静
+	"bu",            // 补
+	"ping",          // 评
+	"fan",           // 翻
+	"rou",           // 肉
+	"jian",          // 践
+	"ni",            // 尼
+	"yi",            // 衣
+	"kuan",          // 宽
+	"yang",          // 扬
+	"mian",          // 棉
+	"xi",            // 希
+	"shang",         // 伤
+	"cao",           // 操
+	"chui",          // 垂
+	"qiu",           // 秋
+	"yi",            // 宜
+	"qing",          // 氢
+	"tao",           // 套
+	"du",            // 督
+	"zhen",          // 振
+	"jia",           // 架
+	"liang",         // 亮
+	"mo",            // 末
+	"xian",          // 宪
+	"qing",          // 庆
+	"bian",          // 编
+	"niu",           // 牛
+	"chu",           // 触
+	"ying",          // 映
+	"lei",           // 雷
+	"xiao",          // 销
+	"shi",           // 诗
+	"zuo",           // 座
+	"ju",            // 居
+	"zhua",          // 抓
+	"lie",           // 裂
+	"bao",           // 胞
+	"hu",            // 呼
+	"niang",         // 娘
+	"jing",          // 景
+	"wei",           // 威
+	"lv lu",         // 绿
+	"jing",          // 晶
+	"hou",           // 厚
+	"meng",          // 盟
+	"heng",          // 衡
+	"ji",            // 鸡
+	"sun",           // 孙
+	"yan",           // 延
+	"wei",           // 危
+	"jiao",          // 胶
+	"wu",            // 屋
+	"xiang",         // 乡
+	"lin",           // 临
+	"lu liu",        // 陆
+	"gu",            // 顾
+	"diao",          // 掉
+	"ya",            // 呀
+	"deng",          // 灯
+	"sui",           // 岁
+	"cuo",           // 措
+	"shu",           // 束
+	"nai",           // 耐
+	"ju",            // 剧
+	"yu",            // 玉
+	"zhao",          // 赵
+	"tiao",          // 跳
+	"ge",            // 哥
+	"ji",            // 季
+	"ke",            // 课
+	"kai",           // 凯
+	"hu",            // 胡
+	"e",             // 额
+	"kuan",          // 款
+	"shao",          // 绍
+	"juan",          // 卷
+	"qi",            // 齐
+	"wei",           // 伟
+	"zheng",         // 蒸
+	"zhi shi",       // 殖
+	"yong",          // 永
+	"zong",          // 宗
+	"miao",          // 苗
+	"chuan",         // 川
+	"lu",            // 炉
+	"yan",           // 岩
+	"ruo",           // 弱
+	"ling",          // 零
+	"yang",          // 杨
+	"zou",           // 奏
+	"yan",           // 沿
+	"lu lou",        // 露
+	"gan",           // 杆
+	"tan",           // 探
+	"hua",           // 滑
+	"zhen",          // 镇
+	"fan",           // 饭
+	"nong",          // 浓
+	"hang",          // 航
+	"huai",          // 怀
+	"gan",           // 赶
+	"ku",            // 库
+	"duo",           // 夺
+	"yi",            // 伊
+	"ling",          // 灵
+	"shui",          // 税
+	"tu",            // 途
+	"mie",           // 灭
+	"sai",           // 赛
+	"gui",           // 归
+	"zhao",          // 召
+	"gu",            // 鼓
+	"bo",            // 播
+	"pan",           // 盘
+	"cai",           // 裁
+	"xian",          // 险
+	"kang",          // 康
+	"wei",           // 唯
+	"lu",            // 录
+	"jun",           // 菌
+	"chun",          // 纯
+	"jie",           // 借
+	"tang",          // 糖
+	"gai",           // 盖
+	"heng",          // 横
+	"fu",            // 符
+	"si",            // 私
+	"nu",            // 努
+	"tang",          // 堂
+	"yu",            // 域
+	"qiang",         // 枪
+	"run",           // 润
+	"fu",            // 幅
+	"ha",            // 哈
+	"jing",          // 竟
+	"shu shou",      // 熟
+	"chong",         // 虫
+	"ze",            // 泽
+	"nao",           // 脑
+	"rang",          // 壤
+	"tan",           // 碳
+	"ou",            // 欧
+	"bian",          // 遍
+	"ce",            // 侧
+	"zhai",          // 寨
+	"gan",           // 敢
+	"che",           // 彻
+	"lv",            // 虑
+	"xie",           // 斜
+	"bo bao",        // 薄
+	"ting",          // 庭
+	"na",            // 纳
+	"dan tan",       // 弹
+	"si",            // 饲
+	"shen",          // 伸
+	"zhe she",       // 折
+	"mai",           // 麦
+	"shi",           // 湿
+	"an",            // 暗
+	"he",            // 荷
+	"wa",            // 瓦
+	"sai se",        // 塞
+	"chuang",        // 床
+	"zhu",           // 筑
+	"e wu",          // 恶
+	"hu",            // 户
+	"fang",          // 访
+	"ta",            // 塔
+	"qi ji",         // 奇
+	"tou",           // 透
+	"liang",         // 梁
+	"dao",           // 刀
+	"xuan",          // 旋
+	"ji",            // 迹
+	"ka qia",        // 卡
+	"lv",            // 氯
+	"yu",            // 遇
+	"fen",           // 份
+	"du",            // 毒
+	"ni",            // 泥
+	"tui",           // 退
+	"xi",            // 洗
+	"bai",           // 摆
+	"hui",           // 灰
+	"cai",           // 彩
+	"mai",           // 卖
+	"hao",           // 耗
+	"xia",           // 夏
+	"ze zhai",       // 择
+	"mang",          // 忙
+	"tong",          // 铜
+	"xian",          // 献
+	"ying",          // 硬
+	"yu",            // 予
+	"fan",           // 繁
+	"quan juan",     // 圈
+	"xue",           // 雪
+	"han",           // 函
+	"yi",            // 亦
+	"chou",          // 抽
+	"pian",          // 篇
+	"zhen",          // 阵
+	"yin",           // 阴
+	"ding",          // 丁
+	"chi",           // 尺
+	"zhui",          // 追
+	"dui",           // 堆
+	"xiong",         // 雄
+	"ying",          // 迎
+	"fan",           // 泛
+	"ba",            // 爸
+	"lou",           // 楼
+	"bi",            // 避
+	"mou",           // 谋
+	"dun",           // 吨
+	"ye",            // 野
+	"zhu",           // 猪
+	"qi",            // 旗
+	"lei",           // 累
+	"pian",          // 偏
+	"dian",          // 典
+	"guan",          // 馆
+	"suo",           // 索
+	"qin",           // 秦
+	"zhi",           // 脂
+	"chao",          // 潮
+	"ye",            // 爷
+	"dou",           // 豆
+	"hu",            // 忽
+	"tuo",           // 托
+	"jing",          // 惊
+	"su",            // 塑
+	"yi",            // 遗
+	"yu",            // 愈
+	"zhu",           // 朱
+	"ti",            // 替
+	"xian qian",     // 纤
+	"cu",            // 粗
+	"qing",          // 倾
+	"shang",         // 尚
+	"tong",          // 痛
+	"chu",           // 楚
+	"xie",           // 谢
+	"fen",           // 奋
+	"gou",           // 购
+	"mo",            // 磨
+	"jun",           // 君
+	"chi",           // 池
+	"pang",          // 旁
+	"sui",           // 碎
+	"gu",            // 骨
+	"jian",          // 监
+	"bu",            // 捕
+	"di",            // 弟
+	"bao pu",        // 暴
+	"ge",            // 割
+	"guan",          // 贯
+	"shu",           // 殊
+	"shi",           // 释
+	"ci",            // 词
+	"wang",          // 亡
+	"bi",            // 壁
+	"dun",           // 顿
+	"bao",           // 宝
+	"wu",            // 午
+	"chen",          // 尘
+	"wen",           // 闻
+	"jie",           // 揭
+	"pao",           // 炮
+	"can",           // 残
+	"dong",          // 冬
+	"qiao",          // 桥
+	"fu",            // 妇
+	"jing",          // 警
+	"zong",          // 综
+	"zhao",          // 招
+	"wu",            // 吴
+	"fu",            // 付
+	"fu",            // 浮
+	"zao",           // 遭
+	"xu",            // 徐
+	"nin",           // 您
+	"yao",           // 摇
+	"gu",            // 谷
+	"zan",           // 赞
+	"xiang",         // 箱
+	"ge",            // 隔
+	"ding",          // 订
+	"nan",           // 男
+	"chui",          // 吹
+	"yuan",          // 园
+	"fen",           // 纷
+	"tang",          // 唐
+	"bai",           // 败
+	"song",          // 宋
+	"bo",            // 玻
+	"ju",            // 巨
+	"geng",          // 耕
+	"tan",           // 坦
+	"rong",          // 荣
+	"bi",            // 闭
+	"wan",           // 湾
+	"jian",          // 键
+	"fan",           // 凡
+	"zhu",           // 驻
+	"guo",           // 锅
+	"jiu",           // 救
+	"en",            // 恩
+	"bo bao",        // 剥
+	"ning",          // 凝
+	"jian",          // 碱
+	"chi",           // 齿
+	"jie",           // 截
+	"lian",          // 炼
+	"ma",            // 麻
+	"fang",          // 纺
+	"jin",           // 禁
+	"fei",           // 废
+	"sheng cheng",   // 盛
+	"ban",           // 版
+	"huan",          // 缓
+	"jing",          // 净
+	"jing",          // 睛
+	"chang",         // 昌
+	"hun",           // 婚
+	"she",           // 涉
+	"tong",          // 筒
+	"zui",           // 嘴
+	"cha",           // 插
+	"an",            // 岸
+	"lang",          // 朗
+	"zhuang",        // 庄
+	"jie",           // 街
+	"cang zang",     // 藏
+	"gu",            // 姑
+	"mao",           // 贸
+	"fu",            // 腐
+	"nu",            // 奴
+	"la",            // 啦
+	"guan",          // 惯
+	"cheng",         // 乘
+	"huo",           // 伙
+	"hui",           // 恢
+	"yun",           // 匀
+	"sha",           // 纱
+	"zha za",        // 扎
+	"bian",          // 辩
+	"er",            // 耳
+	"biao",          // 彪
+	"chen",          // 臣
+	"yi",            // 亿
+	"li",            // 璃
+	"di",            // 抵
+	"mai mo",        // 脉
+	"xiu",           // 秀
+	"sa",            // 萨
+	"e",             // 俄
+	"wang",          // 网
+	"wu",            // 舞
+	"dian",          // 店
+	"pen",           // 喷
+	"zong",          // 纵
+	"cun",           // 寸
+	"han",           // 汗
+	"gua",           // 挂
+	"hong",          // 洪
+	"he",            // 贺
+	"shan",          // 闪
+	"jian",          // 柬
+	"bao",           // 爆
+	"xi",            // 烯
+	"jin",           // 津
+	"dao",           // 稻
+	"qiang",         // 墙
+	"ruan",          // 软
+	"yong",          // 勇
+	"xiang",         // 像
+	"gun",           // 滚
+	"li",            // 厘
+	"meng",          // 蒙
+	"fang",          // 芳
+	"ken",           // 肯
+	"po",            // 坡
+	"zhu",           // 柱
+	"dang",          // 荡
+	"tui",           // 腿
+	"yi",            // 仪
+	"lv",            // 旅
+	"wei yi",        // 尾
+	"ya zha",        // 轧
+	"bing",          // 冰
+	"gong",          // 贡
+	"deng",          // 登
+	"li",            // 黎
+	"xiao xue",      // 削
+	"zuan",          // 钻
+	"le lei",        // 勒
+	"tao",           // 逃
+	"zhang",         // 障
+	"an",            // 氨
+	"guo",           // 郭
+	"feng",          // 峰
+	"bi",            // 币
+	"gang",          // 港
+	"fu",            // 伏
+	"gui",           // 轨
+	"mu",            // 亩
+	"bi",            // 毕
+	"ca",            // 擦
+	"mo",            // 莫
+	"ci",            // 刺
+	"lang",          // 浪
+	"mi bi",         // 秘
+	"yuan",          // 援
+	"zhu",           // 株
+	"jian",          // 健
+	"shou",          // 售
+	"gu",            // 股
+	"dao",           // 岛
+	"gan",           // 甘
+	"pao",           // 泡
+	"shui",          // 睡
+	"tong",          // 童
+	"zhu",           // 铸
+	"tang",          // 汤
+	"fa",            // 阀
+	"xiu",           // 休
+	"hui",           // 汇
+	"she",           // 舍
+	"mu",            // 牧
+	"rao",           // 绕
+	"zha",           // 炸
+	"zhe",           // 哲
+	"lin",           // 磷
+	"ji",            // 绩
+	"peng",          // 朋
+	"dan",           // 淡
+	"jian",          // 尖
+	"qi",            // 启
+	"xian",          // 陷
+	"chai",          // 柴
+	"cheng",         // 呈
+	"tu",            // 徒
+	"yan",           // 颜
+	"lei",           // 泪
+	"shao",          // 稍
+	"wang",          // 忘
+	"beng",          // 泵
+	"lan",           // 蓝
+	"tuo",           // 拖
+	"dong",          // 洞
+	"shou",          // 授
+	"jing",          // 镜
+	"xin",           // 辛
+	"zhuang",        // 壮
+	"feng",          // 锋
+	"pin",           // 贫
+	"xu",            // 虚
+	"wan",           // 弯
+	"mo",            // 摩
+	"tai",           // 泰
+	"you",           // 幼
+	"ting",          // 廷
+	"zun",           // 尊
+	"chuang",        // 窗
+	"gang",          // 纲
+	"nong long",     // 弄
+	"li",            // 隶
+	"yi",            // 疑
+	"shi",           // 氏
+	"gong",          // 宫
+	"jie",           // 姐
+	"zhen",          // 震
+	"rui",           // 瑞
+	"guai",          // 怪
+	"you",           // 尤
+	"qin",           // 琴
+	"xun",           // 循
+	"miao",          // 描
+	"mo",            // 膜
+	"wei",           // 违
+	"jia",           // 夹
+	"yao",           // 腰
+	"yuan",          // 缘
+	"zhu",           // 珠
+	"qiong",         // 穷
+	"sen",           // 森
+	"zhi",           // 枝
+	"zhu",           // 竹
+	"gou",           // 沟
+	"cui",           // 催
+	"sheng",         // 绳
+	"yi",            // 忆
+	"bang",          // 邦
+	"sheng",         // 剩
+	"xing",          // 幸
+	"jiang",         // 浆
+	"lan",           // 栏
+	"yong",          // 拥
+	"ya",            // 牙
+	"zhu",           // 贮
+	"li",            // 礼
+	"lv",            // 滤
+	"na",            // 钠
+	"wen",           // 纹
+	"ba",            // 罢
+	"pai",           // 拍
+	"zan za",        // 咱
+	"han",           // 喊
+	"xiu",           // 袖
+	"ai",            // 埃
+	"qin",           // 勤
+	"fa",            // 罚
+	"jiao",          // 焦
+	"qian",          // 潜
+	"wu",            // 伍
+	"mo",            // 墨
+	"yu",            // 欲
+	"feng",          // 缝
+	"xing",          // 姓
+	"kan",           // 刊
+	"bao",           // 饱
+	"fang",          // 仿
+	"jiang",         // 奖
+	"lv",            // 铝
+	"gui",           // 鬼
+	"li",            // 丽
+	"kua",           // 跨
+	"mo",            // 默
+	"wa",            // 挖
+	"lian",          // 链
+	"sao",           // 扫
+	"he",            // 喝
+	"dai",           // 袋
+	"tan",           // 炭
+	"wu",            // 污
+	"mu",            // 幕
+	"zhu",           // 诸
+	"hu",            // 弧
+	"li",            // 励
+	"mei",           // 梅
+	"nai",           // 奶
+	"jie",           // 洁
+	"zai",           // 灾
+	"zhou",          // 舟
+	"jian",          // 鉴
+	"ben",           // 苯
+	"song",          // 讼
+	"bao",           // 抱
+	"hui",           // 毁
+	"dong",          // 懂
+	"han",           // 寒
+	"zhi",           // 智
+	"pu bu",         // 埔
+	"ji",            // 寄
+	"jie",           // 届
+	"yue",           // 跃
+	"du",            // 渡
+	"tiao",          // 挑
+	"dan",           // 丹
+	"jian",          // 艰
+	"bei",           // 贝
+	"peng",          // 碰
+	"ba",            // 拔
+	"die",           // 爹
+	"dai",           // 戴
+	"ma",            // 码
+	"meng",          // 梦
+	"ya",            // 芽
+	"rong",          // 熔
+	"chi",           // 赤
+	"yu",            // 渔
+	"ku",            // 哭
+	"jing",          // 敬
+	"ke",            // 颗
+	"ben",           // 奔
+	"qian",          // 铅
+	"zhong",         // 仲
+	"hu",            // 虎
+	"xi",            // 稀
+	"mei",           // 妹
+	"fa",            // 乏
+	"zhen",          // 珍
+	"shen",          // 申
+	"zhuo",          // 桌
+	"zun",           // 遵
+	"yun",           // 允
+	"long",          // 隆
+	"luo",           // 螺
+	"cang",          // 仓
+	"wei",           // 魏
+	"rui",           // 锐
+	"xiao",          // 晓
+	"dan",           // 氮
+	"jian",          // 兼
+	"yin",           // 隐
+	"ai",            // 碍
+	"he",            // 赫
+	"bo",            // 拨
+	"zhong",         // 忠
+	"su",            // 肃
+	"gang",          // 缸
+	"qian",          // 牵
+	"qiang",         // 抢
+	"bo",            // 博
+	"qiao",          // 巧
+	"ke qiao",       // 壳
+	"xiong",         // 兄
+	"du",            // 杜
+	"xun",           // 讯
+	"cheng",         // 诚
+	"bi",            // 碧
+	"xiang",         // 祥
+	"ke",            // 柯
+	"ye",            // 页
+	"xun",           // 巡
+	"ju",            // 矩
+	"bei",           // 悲
+	"guan",          // 灌
+	"ling",          // 龄
+	"lun",           // 伦
+	"piao",          // 票
+	"xun",           // 寻
+	"gui",           // 桂
+	"pu",            // 铺
+	"sheng",         // 圣
+	"kong",          // 恐
+	"qia",           // 恰
+	"zheng",         // 郑
+	"qu",            // 趣
+	"tai",           // 抬
+	"huang",         // 荒
+	"teng",          // 腾
+	"tie",           // 贴
+	"rou",           // 柔
+	"di",            // 滴
+	"meng",          // 猛
+	"kuo",           // 阔
+	"liang",         // 辆
+	"qi",            // 妻
+	"tian",          // 填
+	"che",           // 撤
+	"chu",           // 储
+	"qian",          // 签
+	"nao",           // 闹
+	"rao",           // 扰
+	"zi",            // 紫
+	"sha",           // 砂
+	"di",            // 递
+	"xi",            // 戏
+	"diao",          // 吊
+	"tao",           // 陶
+	"fa",            // 伐
+	"wei",           // 喂
+	"liao",          // 疗
+	"ping",          // 瓶
+	"po",            // 婆
+	"fu",            // 抚
+	"bi",            // 臂
+	"mo",            // 摸
+	"ren",           // 忍
+	"xia ha",        // 虾
+	"la",            // 蜡
+	"lin",           // 邻
+	"xiong",         // 胸
+	"gong",          // 巩
+	"ji",            // 挤
+	"ou",            // 偶
+	"qi",            // 弃
+	"cao",           // 槽
+	"jin jing",      // 劲
+	"ru",            // 乳
+	"deng",          // 邓
+	"ji",            // 吉
+	"ren",           // 仁
+	"lan",           // 烂
+	"zhuan",         // 砖
+	"zu",            // 租
+	"wu",            // 乌
+	"jian",          // 舰
+	"ban",           // 伴
+	"gua",           // 瓜
+	"qian",          // 浅
+	"bing",          // 丙
+	"zan",           // 暂
+	"zao",           // 燥
+	"xiang",         // 橡
+	"liu",           // 柳
+	"mi",            // 迷
+	"nuan",          // 暖
+	"pai",           // 牌
+	"yang",          // 秧
+	"dan",           // 胆
+	"xiang",         // 详
+	"huang",         // 簧
+	"ta",            // 踏
+	"ci",            // 瓷
+	"pu",            // 谱
+	"dai",           // 呆
+	"bin",           // 宾
+	"hu",            // 糊
+	"luo",           // 洛
+	"hui",           // 辉
+	"fen",           // 愤
+	"jing",          // 竞
+	"xi",            // 隙
+	"nu",            // 怒
+	"nian zhan",     // 粘
+	"nai",           // 乃
+	"xu",            // 绪
+	"jian",          // 肩
+	"ji",            // 籍
+	"min",           // 敏
+	"tu",            // 涂
+	"xi",            // 熙
+	"jie",           // 皆
+	"zhen",          // 侦
+	"xuan",          // 悬
+	"jue",           // 掘
+	"xiang",         // 享
+	"jiu",           // 纠
+	"xing",          // 醒
+	"kuang",         // 狂
+	"suo",           // 锁
+	"dian",          // 淀
+	"hen",           // 恨
+	"sheng",         // 牲
+	"ba",            // 霸
+	"pa",            // 爬
+	"shang",         // 赏
+	"ni",            // 逆
+	"wan",           // 玩
+	"ling",          // 陵
+	"zhu",           // 祝
+	"miao",          // 秒
+	"zhe",           // 浙
+	"mao",           // 貌
+	"yi",            // 役
+	"bi",            // 彼
+	"xi",            // 悉
+	"ya",            // 鸭
+	"qu",            // 趋
+	"feng",          // 凤
+	"chen",          // 晨
+	"chu xu",        // 畜
+	"bei",           // 辈
+	"zhi",           // 秩
+	"luan",          // 卵
+	"shu",           // 署
+	"ti",            // 梯
+	"yan",           // 炎
+	"tan",           // 滩
+	"qi",            // 棋
+	"qu",            // 驱
+	"shai",          // 筛
+	"xia",           // 峡
+	"mao",           // 冒
+	"sha",           // 啥
+	"shou",          // 寿
+	"yi",            // 译
+	"jin",           // 浸
+	"quan",          // 泉
+	"mao",           // 帽
+	"chi",           // 迟
+	"gui",           // 硅
+	"jiang",         // 疆
+	"dai",           // 贷
+	"lou",           // 漏
+	"gao",           // 稿
+	"guan",          // 冠
+	"nen",           // 嫩
+	"xie",           // 胁
+	"xin",           // 芯
+	"lao",           // 牢
+	"pan",           // 叛
+	"shi",           // 蚀
+	"ao",            // 奥
+	"ming",          // 鸣
+	"ling",          // 岭
+	"yang",          // 羊
+	"ping",          // 凭
+	"chuan",         // 串
+	"tang",          // 塘
+	"hui",           // 绘
+	"jiao",          // 酵
+	"rong",          // 融
+	"pen",           // 盆
+	"xi",            // 锡
+	"miao",          // 庙
+	"chou",          // 筹
+	"dong",          // 冻
+	"fu",            // 辅
+	"she",           // 摄
+	"xi",            // 袭
+	"jin",           // 筋
+	"ju",            // 拒
+	"liao",          // 僚
+	"han",           // 旱
+	"jia",           // 钾
+	"niao",          // 鸟
+	"qi",            // 漆
+	"shen chen",     // 沈
+	"mei",           // 眉
+	"shu",           // 疏
+	"tian",          // 添
+	"bang",          // 棒
+	"sui",           // 穗
+	"xiao",          // 硝
+	"han",           // 韩
+	"bi",            // 逼
+	"niu",           // 扭
+	"qiao",          // 侨
+	"liang",         // 凉
+	"ting",          // 挺
+	"wan",           // 碗
+	"zai",           // 栽
+	"chao",          // 炒
+	"bei",           // 杯
+	"huan",          // 患
+	"liu",           // 馏
+	"quan",          // 劝
+	"hao",           // 豪
+	"liao",          // 辽
+	"bo",            // 勃
+	"hong",          // 鸿
+	"dan",           // 旦
+	"li",            // 吏
+	"bai",           // 拜
+	"gou",           // 狗
+	"mai man",       // 埋
+	"gun",           // 辊
+	"yan",           // 掩
+	"yin",           // 饮
+	"ban",           // 搬
+	"ma",            // 骂
+	"ci",            // 辞
+	"gou",           // 勾
+	"kou",           // 扣
+	"gu",            // 估
+	"jiang",         // 蒋
+	"rong",          // 绒
+	"wu",            // 雾
+	"zhang",         // 丈
+	"duo",           // 朵
+	"mu",            // 姆
+	"ni",            // 拟
+	"yu",            // 宇
+	"ji",            // 辑
+	"shan",          // 陕
+	"diao",          // 雕
+	"chang",         // 偿
+	"xu",            // 蓄
+	"chong",         // 崇
+	"jian",          // 剪
+	"chang",         // 倡
+	"ting",          // 厅
+	"yao",           // 咬
+	"shi",           // 驶
+	"shu",           // 薯
+	"shua",          // 刷
+	"chi",           // 斥
+	"fan",           // 番
+	"fu",            // 赋
+	"feng",          // 奉
+	"fo fu",         // 佛
+	"jiao",          // 浇
+	"man",           // 漫
+	"man",           // 曼
+	"shan",          // 扇
+	"gai",           // 钙
+	"tao",           // 桃
+	"fu",            // 扶
+	"zi zai",        // 仔
+	"fan",           // 返
+	"su",            // 俗
+	"kui",           // 亏
+	"qiang",         // 腔
+	"xie",           // 鞋
+	"leng",          // 棱
+	"fu",            // 覆
+	"kuang",         // 框
+	"qiao",          // 悄
+	"shu",           // 叔
+	"zhuang",        // 撞
+	"pian",          // 骗
+	"kan",           // 勘
+	"wang",          // 旺
+	"fei",           // 沸
+	"gu",            // 孤
+	"tu",            // 吐
+	"meng",          // 孟
+	"qu",            // 渠
+	"qu",            // 屈
+	"ji",            // 疾
+	"miao",          // 妙
+	"xi",            // 惜
+	"yang",          // 仰
+	"hen",           // 狠
+	"zhang",         // 胀
+	"xie",           // 谐
+	"pao",           // 抛
+	"mei",           // 霉
+	"sang",          // 桑
+	"gang",          // 岗
+	"ma",            // 嘛
+	"shuai",         // 衰
+	"dao",           // 盗
+	"shen",          // 渗
+	"zang",          // 脏
+	"lai",           // 赖
+	"yong",          // 涌
+	"tian",          // 甜
+	"cao",           // 曹
+	"yue",           // 阅
+	"ji",            // 肌
+	"li",            // 哩
+	"li",            // 厉
+	"ting",          // 烃
+	"wei",           // 纬
+	"yi",            // 毅
+	"zuo",           // 昨
+	"wei",           // 伪
+	"zheng",         // 症
+	"zhu",           // 煮
+	"tan",           // 叹
+	"ding",          // 钉
+	"da",            // 搭
+	"jing",          // 茎
+	"long",          // 笼
+	"ku",            // 酷
+	"tou",           // 偷
+	"gong",          // 弓
+	"zhui",          // 锥
+	"heng",          // 恒
+	"jie",           // 杰
+	"keng",          // 坑
+	"bi",            // 鼻
+	"yi",            // 翼
+	"lun",           // 纶
+	"xu",            // 叙
+	"yu",            // 狱
+	"dai",           // 逮
+	"guan",          // 罐
+	"luo",           // 络
+	"peng",          // 棚
+	"yi",            // 抑
+	"peng",          // 膨
+	"shu",           // 蔬
+	"si",            // 寺
+	"zhou",          // 骤
+	"mu",            // 穆
+	"ye",            // 冶
+	"ku",            // 枯
+	"ce",            // 册
+	"shi",           // 尸
+	"tu",            // 凸
+	"shen",          // 绅
+	"pi",            // 坯
+	"xi",            // 牺
+	"yan",           // 焰
+	"hong",          // 轰
+	"xin",           // 欣
+	"jin",           // 晋
+	"shou",          // 瘦
+	"yu",            // 御
+	"ding",          // 锭
+	"jin",           // 锦
+	"sang",          // 丧
+	"xun",           // 旬
+	"duan",          // 锻
+	"long",          // 垄
+	"sou",           // 搜
+	"pu",            // 扑
+	"yao",           // 邀
+	"ting",          // 亭
+	"zhi",           // 酯
+	"mai",           // 迈
+	"shu",           // 舒
+	"cui",           // 脆
+	"mei",           // 酶
+	"xian",          // 闲
+	"you",           // 忧
+	"fen",           // 酚
+	"wan",           // 顽
+	"yu",            // 羽
+	"zhang",         // 涨
+	"xie",           // 卸
+	"zhang",         // 仗
+	"pei",           // 陪
+	"pi bi",         // 辟
+	"cheng",         // 惩
+	"hang",          // 杭
+	"yao",           // 姚
+	"du",            // 肚
+	"zhuo",          // 捉
+	"piao",          // 飘
+	"piao",          // 漂
+	"kun",           // 昆
+	"qi",            // 欺
+	"wu",            // 吾
+	"lang",          // 郎
+	"wan",           // 烷
+	"zhi",           // 汁
+	"he a",          // 呵
+	"shi",           // 饰
+	"xiao",          // 萧
+	"ya",            // 雅
+	"you",           // 邮
+	"qian",          // 迁
+	"yan",           // 燕
+	"sa",            // 撒
+	"yin",           // 姻
+	"fu",            // 赴
+	"yan",           // 宴
+	"fan",           // 烦
+	"zhai",          // 债
+	"zhang",         // 帐
+	"ban",           // 斑
+	"ling",          // 铃
+	"zhi",           // 旨
+	"chun",          // 醇
+	"dong",          // 董
+	"bing",          // 饼
+	"chu",           // 雏
+	"zi",            // 姿
+	"ban",           // 拌
+	"fu",            // 傅
+	"fu",            // 腹
+	"tuo",           // 妥
+	"rou",           // 揉
+	"xian",          // 贤
+	"chai",          // 拆
+	"wai",           // 歪
+	"pu",            // 葡
+	"an",            // 胺
+	"diu",           // 丢
+	"hao",           // 浩
+	"hui",           // 徽
+	"ang",           // 昂
+	"dian",          // 垫
+	"dang",          // 挡
+	"lan",           // 览
+	"tan",           // 贪
+	"wei",           // 慰
+	"jiao",          // 缴
+	"wang",          // 汪
+	"huang",         // 慌
+	"feng",          // 冯
+	"nuo",           // 诺
+	"jiang",         // 姜
+	"yi",            // 谊
+	"xiong",         // 凶
+	"lie",           // 劣
+	"wu",            // 诬
+	"yao",           // 耀
+	"hun",           // 昏
+	"tang",          // 躺
+	"ying",          // 盈
+	"qi",            // 骑
+	"qiao",          // 乔
+	"xi",            // 溪
+	"cong",          // 丛
+	"lu",            // 卢
+	"mo",            // 抹
+	"men",           // 闷
+	"zi",            // 咨
+	"gua",           // 刮
+	"jia",           // 驾
+	"lan",           // 缆
+	"wu",            // 悟
+	"zhai",          // 摘
+	"er",            // 铒
+	"zhi",           // 掷
+	"po",            // 颇
+	"huan",          // 幻
+	"bing",          // 柄
+	"hui",           // 惠
+	"can",           // 惨
+	"jia",           // 佳
+	"chou",          // 仇
+	"la",            // 腊
+	"wo",            // 窝
+	"di",            // 涤
+	"jian",          // 剑
+	"qiao",          // 瞧
+	"bao",           // 堡
+	"po",            // 泼
+	"cong",          // 葱
+	"zhao",          // 罩
+	"huo",           // 霍
+	"lao",           // 捞
+	"tai",           // 胎
+	"cang",          // 苍
+	"bin",           // 滨
+	"lia",           // 俩
+	"tong",          // 捅
+	"xiang",         // 湘
+	"kan",           // 砍
+	"xia",           // 霞
+	"shao",          // 邵
+	"tao",           // 萄
+	"feng",          // 疯
+	"huai",          // 淮
+	"sui",           // 遂
+	"xiong",         // 熊
+	"fen",           // 粪
+	"hong",          // 烘
+	"su xiu",        // 宿
+	"dang",          // 档
+	"ge",            // 戈
+	"bo",            // 驳
+	"sao",           // 嫂
+	"yu",            // 裕
+	"xi",            // 徙
+	"jian",          // 箭
+	"juan",          // 捐
+	"chang",         // 肠
+	"cheng",         // 撑
+	"shai",          // 晒
+	"bian",          // 辨
+	"dian",          // 殿
+	"lian",          // 莲
+	"tan",           // 摊
+	"jiao",          // 搅
+	"jiang",         // 酱
+	"ping bing",     // 屏
+	"yi",            // 疫
+	"ai",            // 哀
+	"cai",           // 蔡
+	"du",            // 堵
+	"mo",            // 沫
+	"zhou",          // 皱
+	"chang",         // 畅
+	"die",           // 叠
+	"ge",            // 阁
+	"lai",           // 莱
+	"qiao",          // 敲
+	"xia",           // 辖
+	"gou",           // 钩
+	"hen",           // 痕
+	"ba",            // 坝
+	"xiang hang",    // 巷
+	"e",             // 饿
+	"huo",           // 祸
+	"qiu",           // 丘
+	"xuan",          // 玄
+	"liu",           // 溜
+	"yue",           // 曰
+	"luo",           // 逻
+	"peng",          // 彭
+	"chang",         // 尝
+	"qing",          // 卿
+	"fang",          // 妨
+	"ting",          // 艇
+	"tun",           // 吞
+	"wei",           // 韦
+	"yuan",          // 怨
+	"ai",            // 矮
+	"xie",           // 歇
+}
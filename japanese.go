@@ -0,0 +1,233 @@
+package bip39
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FormatOptions are the FormatMnemonic options
+type FormatOptions struct {
+	// Romaji writes Japanese words in Hepburn romaji separated by spaces,
+	// it is meant to be read out, the seed must be derived from the
+	// canonical hiragana sentence
+	Romaji bool
+}
+
+// FormatMnemonic validates the mnemonic as ParseMnemonic does and renders it
+// with the options, the canonical sentence is returned by default
+func FormatMnemonic(mnemonic string, lang Language, opts FormatOptions) (string, error) {
+	canonical, err := ParseMnemonic(mnemonic, lang, ParseOptions{})
+	if err != nil {
+		return "", err
+	}
+	if !opts.Romaji || lang != Japanese {
+		return canonical, nil
+	}
+	words := strings.Split(canonical, Japanese.Info().Separator)
+	for i, w := range words {
+		words[i] = toRomaji(w)
+	}
+	return strings.Join(words, "\x20"), nil
+}
+
+// japaneseWord returns the list word of a word typed in hiragana, katakana
+// or Hepburn romaji, the input word is returned as is if there is none
+func japaneseWord(word string) string {
+	mapping := Japanese.mapping()
+	if _, ok := mapping[word]; ok {
+		return word
+	}
+	candidates := []string{toHiragana(word)}
+	if romaji, ok := fromRomaji(word); ok {
+		candidates = append(candidates, romaji...)
+	}
+	for _, c := range candidates {
+		c = norm.NFKD.String(c)
+		if _, ok := mapping[c]; ok {
+			return c
+		}
+	}
+	return word
+}
+
+// toHiragana converts the katakana of the word to hiragana
+func toHiragana(word string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'ァ' && r <= 'ヶ' {
+			return r - ('ァ' - 'ぁ')
+		}
+		return r
+	}, norm.NFC.String(word))
+}
+
+// romaji of the kana, the palatalized syllables are in romajiYoon
+var romajiKana = map[string]string{
+	"あ": "a", "い": "i", "う": "u", "え": "e", "お": "o",
+	"か": "ka", "き": "ki", "く": "ku", "け": "ke", "こ": "ko",
+	"が": "ga", "ぎ": "gi", "ぐ": "gu", "げ": "ge", "ご": "go",
+	"さ": "sa", "し": "shi", "す": "su", "せ": "se", "そ": "so",
+	"ざ": "za", "じ": "ji", "ず": "zu", "ぜ": "ze", "ぞ": "zo",
+	"た": "ta", "ち": "chi", "つ": "tsu", "て": "te", "と": "to",
+	"だ": "da", "ぢ": "ji", "づ": "zu", "で": "de", "ど": "do",
+	"な": "na", "に": "ni", "ぬ": "nu", "ね": "ne", "の": "no",
+	"は": "ha", "ひ": "hi", "ふ": "fu", "へ": "he", "ほ": "ho",
+	"ば": "ba", "び": "bi", "ぶ": "bu", "べ": "be", "ぼ": "bo",
+	"ぱ": "pa", "ぴ": "pi", "ぷ": "pu", "ぺ": "pe", "ぽ": "po",
+	"ま": "ma", "み": "mi", "む": "mu", "め": "me", "も": "mo",
+	"や": "ya", "ゆ": "yu", "よ": "yo",
+	"ら": "ra", "り": "ri", "る": "ru", "れ": "re", "ろ": "ro",
+	"わ": "wa", "を": "o", "ん": "n",
+	"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+}
+
+var romajiYoon = map[string]string{
+	"き": "ky", "ぎ": "gy", "し": "sh", "じ": "j", "ち": "ch", "ぢ": "j",
+	"に": "ny", "ひ": "hy", "び": "by", "ぴ": "py", "み": "my", "り": "ry",
+}
+
+var yoonVowel = map[string]string{"ゃ": "a", "ゅ": "u", "ょ": "o"}
+
+// toRomaji returns the Hepburn romaji of a hiragana word, a syllabic n
+// followed by a vowel or y is written n'
+func toRomaji(word string) string {
+	kana := []rune(norm.NFC.String(word))
+	var sb strings.Builder
+	geminate := false
+	for i := 0; i < len(kana); i++ {
+		k := string(kana[i])
+		var next string
+		if i+1 < len(kana) {
+			next = string(kana[i+1])
+		}
+		var syllable string
+		switch {
+		case k == "っ":
+			geminate = true
+			continue
+		case romajiYoon[k] != "" && yoonVowel[next] != "":
+			syllable = romajiYoon[k] + yoonVowel[next]
+			i++
+		case romajiKana[k+next] != "" && next != "":
+			syllable = romajiKana[k+next]
+			i++
+		default:
+			syllable = romajiKana[k]
+		}
+		if geminate {
+			if strings.HasPrefix(syllable, "ch") {
+				sb.WriteByte('t')
+			} else {
+				sb.WriteByte(syllable[0])
+			}
+			geminate = false
+		}
+		if k == "ん" && next != "" && strings.ContainsAny(romajiKana[next][:1], "aiueoy") {
+			syllable = "n'"
+		}
+		sb.WriteString(syllable)
+	}
+	return sb.String()
+}
+
+// kana of the romaji syllables, Kunrei-shiki spellings are accepted too
+var romajiSyllables = func() map[string]string {
+	m := map[string]string{
+		"si": "し", "zi": "じ", "ti": "ち", "tu": "つ", "hu": "ふ", "di": "ぢ", "du": "づ",
+		"sya": "しゃ", "syu": "しゅ", "syo": "しょ", "zya": "じゃ", "zyu": "じゅ", "zyo": "じょ",
+		"jya": "じゃ", "jyu": "じゅ", "jyo": "じょ", "tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ",
+		"wo": "を",
+	}
+	for kana, romaji := range romajiKana {
+		if _, ok := m[romaji]; !ok && kana != "ぢ" && kana != "づ" && kana != "を" && kana != "ん" {
+			m[romaji] = kana
+		}
+	}
+	for kana, prefix := range romajiYoon {
+		if kana == "ぢ" {
+			continue
+		}
+		for small, vowel := range yoonVowel {
+			m[prefix+vowel] = kana + small
+		}
+	}
+	return m
+}()
+
+// fromRomaji returns the hiragana candidates of a romaji word: the long
+// vowels with a macron and the zu of ず or づ are ambiguous
+func fromRomaji(word string) ([]string, bool) {
+	variants := []string{strings.ToLower(norm.NFC.String(word))}
+	for _, long := range []struct{ macron, spellings string }{
+		{"ā", "aa"}, {"â", "aa"}, {"ī", "ii"}, {"î", "ii"}, {"ū", "uu"}, {"û", "uu"},
+		{"ē", "ei ee"}, {"ê", "ei ee"}, {"ō", "ou oo"}, {"ô", "ou oo"},
+	} {
+		var expanded []string
+		for _, v := range variants {
+			if !strings.Contains(v, long.macron) {
+				expanded = append(expanded, v)
+				continue
+			}
+			for _, s := range strings.Fields(long.spellings) {
+				expanded = append(expanded, strings.ReplaceAll(v, long.macron, s))
+			}
+		}
+		variants = expanded
+	}
+
+	var candidates []string
+	for _, v := range variants {
+		kana, ok := parseRomaji(v)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, kana)
+		if strings.Contains(kana, "ず") {
+			candidates = append(candidates, strings.ReplaceAll(kana, "ず", "づ"))
+		}
+	}
+	return candidates, len(candidates) > 0
+}
+
+func parseRomaji(s string) (string, bool) {
+	var sb strings.Builder
+	for len(s) > 0 {
+		if r, _ := utf8.DecodeRuneInString(s); r >= utf8.RuneSelf {
+			return "", false
+		}
+		c := s[0]
+		switch {
+		case c == 'n' && (len(s) == 1 || !strings.ContainsRune("aiueoy", rune(s[1]))):
+			// n', nn or n before a consonant
+			sb.WriteString("ん")
+			s = s[1:]
+			if strings.HasPrefix(s, "'") || (strings.HasPrefix(s, "n") && (len(s) == 1 || !strings.ContainsRune("aiueoy", rune(s[1])))) {
+				s = s[1:]
+			}
+			continue
+		case len(s) > 1 && !strings.ContainsRune("aiueon", rune(c)) && (s[1] == c || (c == 't' && strings.HasPrefix(s, "tch"))):
+			sb.WriteString("っ")
+			s = s[1:]
+			continue
+		case strings.ContainsRune("aiueo", rune(c)):
+			sb.WriteString(romajiSyllables[s[:1]])
+			s = s[1:]
+			continue
+		}
+		matched := false
+		for n := 3; n >= 2 && !matched; n-- {
+			if len(s) >= n {
+				if kana, ok := romajiSyllables[s[:n]]; ok {
+					sb.WriteString(kana)
+					s = s[n:]
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
@@ -0,0 +1,121 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/islishude/bip39/internal/wordlist"
+	"golang.org/x/text/unicode/norm"
+)
+
+const japaneseMnemonic = "ねほりはほり　ひらがな　とさか　そつう　おうじ　あてな　きくらげ　みもと　してつ　ぱそこん　にってい　いこつ"
+
+func TestRomajiWordlist(t *testing.T) {
+	seen := make(map[string]string, len(wordlist.Japanese))
+	for _, word := range wordlist.Japanese {
+		romaji := toRomaji(word)
+		if other, ok := seen[romaji]; ok {
+			t.Errorf("romaji %q of %q is the one of %q", romaji, word, other)
+		}
+		seen[romaji] = word
+		if got := japaneseWord(romaji); got != word {
+			t.Errorf("japaneseWord(%q) = %q, want %q", romaji, got, word)
+		}
+	}
+}
+
+func TestToRomaji(t *testing.T) {
+	tests := map[string]string{
+		"あいこくしん": "aikokushin",
+		"はろうぃん":  "harowin",
+		"つづく":    "tsuzuku",
+		"あっしゅく":  "asshuku",
+		"きっちり":   "kitchiri",
+		"おうじ":    "ouji",
+		"げきか":    "gekika",
+	}
+	for kana, want := range tests {
+		if got := toRomaji(norm.NFD.String(kana)); got != want {
+			t.Errorf("toRomaji(%q) = %q, want %q", kana, got, want)
+		}
+	}
+}
+
+func TestParseMnemonicJapanese(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "katakana",
+			input: "ネホリハホリ　ヒラガナ　トサカ　ソツウ　オウジ　アテナ　キクラゲ　ミモト　シテツ　パソコン　ニッテイ　イコツ",
+		},
+		{
+			name:  "hepburn",
+			input: "nehorihahori hiragana tosaka sotsuu ouji atena kikurage mimoto shitetsu pasokon nittei ikotsu",
+		},
+		{
+			name:  "kunrei and macrons",
+			input: "Nehorihahori Hiragana Tosaka Sotū Ōzi Atena Kikurage Mimoto Sitetu Pasokon Nittei Ikotu",
+		},
+		{
+			name:  "mixed scripts",
+			input: "ねほりはほり ヒラガナ tosaka そつう オウジ atena きくらげ mimoto してつ パソコン nittei いこつ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMnemonic(tt.input, Japanese, ParseOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != norm.NFD.String(japaneseMnemonic) {
+				t.Errorf("ParseMnemonic() = %q, want %q", got, japaneseMnemonic)
+			}
+		})
+	}
+	if _, err := ParseMnemonic(tests[1].input, Japanese, ParseOptions{Strict: true}); err == nil {
+		t.Error("ParseMnemonic() accepts romaji in strict mode")
+	}
+}
+
+func TestFormatMnemonic(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		lang  Language
+		opts  FormatOptions
+		want  string
+	}{
+		{
+			name:  "japanese canonical",
+			input: "nehorihahori hiragana tosaka sotsuu ouji atena kikurage mimoto shitetsu pasokon nittei ikotsu",
+			lang:  Japanese,
+			want:  norm.NFD.String(japaneseMnemonic),
+		},
+		{
+			name:  "japanese romaji",
+			input: japaneseMnemonic,
+			lang:  Japanese,
+			opts:  FormatOptions{Romaji: true},
+			want:  "nehorihahori hiragana tosaka sotsuu ouji atena kikurage mimoto shitetsu pasokon nittei ikotsu",
+		},
+		{
+			name:  "english ignores romaji",
+			input: "Check Fiscal Fit Sword Unlock Rough Lottery Tool Sting Pluck Bulb Random",
+			lang:  English,
+			opts:  FormatOptions{Romaji: true},
+			want:  "check fiscal fit sword unlock rough lottery tool sting pluck bulb random",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatMnemonic(tt.input, tt.lang, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatMnemonic() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
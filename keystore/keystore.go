@@ -0,0 +1,304 @@
+// Package keystore exports and imports Ethereum keys derived from a mnemonic
+// as Web3 Secret Storage (keystore v3) JSON files.
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// DefaultPath is the BIP44 path of the first Ethereum account
+const DefaultPath = "m/44'/60'/0'/0/0"
+
+// KDF is the key derivation function of the password
+type KDF string
+
+// KDF list
+const (
+	Scrypt KDF = "scrypt"
+	PBKDF2 KDF = "pbkdf2"
+)
+
+const (
+	version    = 3
+	cipherName = "aes-128-ctr"
+	prf        = "hmac-sha256"
+	dkLen      = 32
+)
+
+// limits of the kdf params accepted by Decrypt
+const (
+	maxScryptMemory = 1 << 30 // 128·N·r bytes
+	maxScryptWork   = 4 << 30 // 128·N·r·p bytes hashed
+	maxIterations   = 10_000_000
+)
+
+// Error list
+var (
+	ErrVersion         = errors.New("keystore: unsupported version")
+	ErrUnsupported     = errors.New("keystore: unsupported cipher, kdf or parameters")
+	ErrMAC             = errors.New("keystore: wrong password or corrupted file")
+	ErrAddressMismatch = errors.New("keystore: address does not match the key")
+	ErrInvalidKey      = errors.New("keystore: invalid private key")
+)
+
+// Options are the encryption options, the zero value uses scrypt with the
+// geth standard costs
+type Options struct {
+	KDF KDF
+
+	// scrypt costs, 262144, 8 and 1 by default
+	ScryptN, ScryptR, ScryptP int
+	// PBKDF2 iterations, 262144 by default
+	Iterations int
+
+	// Rand is the salt, iv and id source, crypto/rand by default
+	Rand io.Reader
+}
+
+type keyJSON struct {
+	Address string     `json:"address,omitempty"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string `json:"cipher"`
+	CipherText   string `json:"ciphertext"`
+	CipherParams struct {
+		IV string `json:"iv"`
+	} `json:"cipherparams"`
+	KDF       KDF       `json:"kdf"`
+	KDFParams kdfParams `json:"kdfparams"`
+	MAC       string    `json:"mac"`
+}
+
+type kdfParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+	Salt  string `json:"salt"`
+}
+
+func (p *kdfParams) key(kdf KDF, password string) ([]byte, error) {
+	salt, err := hex.DecodeString(p.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if p.DKLen != dkLen {
+		return nil, ErrUnsupported
+	}
+	switch kdf {
+	case Scrypt:
+		// N is a power of two above 1
+		if p.N < 2 || p.N&(p.N-1) != 0 || p.R <= 0 || p.P <= 0 {
+			return nil, ErrUnsupported
+		}
+		if memory := 128 * uint64(p.N) * uint64(p.R); memory > maxScryptMemory || memory*uint64(p.P) > maxScryptWork {
+			return nil, ErrUnsupported
+		}
+		return scrypt.Key([]byte(password), salt, p.N, p.R, p.P, p.DKLen)
+	case PBKDF2:
+		if p.PRF != prf || p.C <= 0 || p.C > maxIterations {
+			return nil, ErrUnsupported
+		}
+		return pbkdf2.Key([]byte(password), salt, p.C, p.DKLen, sha256.New), nil
+	}
+	return nil, ErrUnsupported
+}
+
+// Export derives the key at path from the BIP39 seed and encrypts it, the
+// DefaultPath is used if path is empty, opts may be nil
+func Export(seed []byte, path, password string, opts *Options) ([]byte, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+	master, err := hdkey.NewMaster(seed)
+	if err != nil {
+		return nil, err
+	}
+	key, err := master.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return Encrypt(key.PrivateKey(), password, opts)
+}
+
+// Encrypt returns the keystore v3 JSON of the private key
+func Encrypt(privateKey []byte, password string, opts *Options) ([]byte, error) {
+	address, err := Address(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = new(Options)
+	}
+	r := opts.Rand
+	if r == nil {
+		r = rand.Reader
+	}
+	random := make([]byte, 32+aes.BlockSize+16)
+	if _, err := io.ReadFull(r, random); err != nil {
+		return nil, err
+	}
+	salt, iv, id := random[:32], random[32:48], random[48:]
+
+	k := keyJSON{Address: strings.ToLower(address[2:]), ID: uuid(id), Version: version}
+	c := &k.Crypto
+	c.Cipher = cipherName
+	c.CipherParams.IV = hex.EncodeToString(iv)
+	c.KDFParams = kdfParams{DKLen: dkLen, Salt: hex.EncodeToString(salt)}
+	switch opts.KDF {
+	case Scrypt, "":
+		c.KDF = Scrypt
+		c.KDFParams.N, c.KDFParams.R, c.KDFParams.P = orDefault(opts.ScryptN, 262144), orDefault(opts.ScryptR, 8), orDefault(opts.ScryptP, 1)
+	case PBKDF2:
+		c.KDF = PBKDF2
+		c.KDFParams.C, c.KDFParams.PRF = orDefault(opts.Iterations, 262144), prf
+	default:
+		return nil, ErrUnsupported
+	}
+
+	derived, err := c.KDFParams.key(c.KDF, password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTR(derived[:16], iv, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	c.CipherText = hex.EncodeToString(cipherText)
+	c.MAC = hex.EncodeToString(keccak256(derived[16:32], cipherText))
+	return json.MarshalIndent(k, "", "  ")
+}
+
+func orDefault(v, def int) int {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+// uuid formats 16 random bytes as a version 4 UUID
+func uuid(b []byte) string {
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Decrypt returns the private key and its address of a keystore v3 JSON
+func Decrypt(data []byte, password string) (privateKey []byte, address string, err error) {
+	var k keyJSON
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, "", err
+	}
+	if k.Version != version {
+		return nil, "", ErrVersion
+	}
+	c := &k.Crypto
+	if c.Cipher != cipherName {
+		return nil, "", ErrUnsupported
+	}
+	cipherText, err := hex.DecodeString(c.CipherText)
+	if err != nil {
+		return nil, "", err
+	}
+	iv, err := hex.DecodeString(c.CipherParams.IV)
+	if err != nil {
+		return nil, "", err
+	}
+	mac, err := hex.DecodeString(c.MAC)
+	if err != nil {
+		return nil, "", err
+	}
+
+	derived, err := c.KDFParams.key(c.KDF, password)
+	if err != nil {
+		return nil, "", err
+	}
+	if !bytes.Equal(keccak256(derived[16:32], cipherText), mac) {
+		return nil, "", ErrMAC
+	}
+	plain, err := aesCTR(derived[:16], iv, cipherText)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(plain) > 32 {
+		return nil, "", ErrInvalidKey
+	}
+	// early geth versions wrote keys without their leading zeros
+	privateKey = make([]byte, 32)
+	copy(privateKey[32-len(plain):], plain)
+
+	address, err = Address(privateKey)
+	if err != nil {
+		return nil, "", err
+	}
+	if k.Address != "" && !strings.EqualFold(strings.TrimPrefix(k.Address, "0x"), address[2:]) {
+		return nil, "", ErrAddressMismatch
+	}
+	return privateKey, address, nil
+}
+
+func aesCTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, ErrUnsupported
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// Address returns the EIP-55 checksummed address of the private key
+func Address(privateKey []byte) (string, error) {
+	if len(privateKey) != 32 {
+		return "", ErrInvalidKey
+	}
+	var s secp256k1.ModNScalar
+	if overflow := s.SetByteSlice(privateKey); overflow || s.IsZero() {
+		return "", ErrInvalidKey
+	}
+	pub := secp256k1.NewPrivateKey(&s).PubKey().SerializeUncompressed()
+	addr := hex.EncodeToString(keccak256(pub[1:])[12:])
+
+	hash := hex.EncodeToString(keccak256([]byte(addr)))
+	out := []byte(addr)
+	for i, c := range out {
+		if c >= 'a' && hash[i] >= '8' {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out), nil
+}
@@ -0,0 +1,141 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/islishude/bip39"
+)
+
+// Web3 Secret Storage test vectors
+func TestDecrypt(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		password string
+		priv     string
+	}{
+		{
+			name:     "scrypt",
+			password: "testpassword",
+			priv:     "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+			json: `{"crypto": {"cipher": "aes-128-ctr", "cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+				"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c", "kdf": "scrypt",
+				"kdfparams": {"dklen": 32, "n": 262144, "r": 1, "p": 8, "salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},
+				"mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},
+				"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6", "version": 3}`,
+		},
+		{
+			name:     "pbkdf2",
+			password: "testpassword",
+			priv:     "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+			json: `{"crypto": {"cipher": "aes-128-ctr", "cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+				"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46", "kdf": "pbkdf2",
+				"kdfparams": {"c": 262144, "dklen": 32, "prf": "hmac-sha256", "salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},
+				"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},
+				"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6", "version": 3}`,
+		},
+		{
+			name:     "31 bytes key",
+			password: "foo",
+			priv:     "00fa7b3db73dc7dfdf8c5fbdb796d741e4488628c41fc4febd9160a866ba0f35",
+			json: `{"crypto": {"cipher": "aes-128-ctr", "cipherparams": {"iv": "e0c41130a323adc1446fc82f724bca2f"},
+				"ciphertext": "9517cd5bdbe69076f9bf5057248c6c050141e970efa36ce53692d5d59a3984", "kdf": "scrypt",
+				"kdfparams": {"dklen": 32, "n": 2, "r": 8, "p": 1, "salt": "711f816911c92d649fb4c84b047915679933555030b3552c1212609b38208c63"},
+				"mac": "d5e116151c6aa71470e67a7d42c9620c75c4d23229847dcc127794f0732b0db5"},
+				"id": "fecfc4ce-e956-48fd-953b-30f8b52ed66c", "version": 3}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priv, _, err := Decrypt([]byte(tt.json), tt.password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(priv) != tt.priv {
+				t.Errorf("Decrypt() = %x, want %s", priv, tt.priv)
+			}
+			if _, _, err := Decrypt([]byte(tt.json), "wrong"); err != ErrMAC {
+				t.Errorf("Decrypt() error = %v, want %v", err, ErrMAC)
+			}
+		})
+	}
+}
+
+func TestDecryptError(t *testing.T) {
+	const key = `{"crypto": {"cipher": "aes-128-ctr", "cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+		"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c", "kdf": "%s",
+		"kdfparams": %s, "mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},
+		"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6", "version": 3}`
+	const salt = `"salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"`
+	tests := []struct {
+		name   string
+		kdf    KDF
+		params string
+	}{
+		{"dklen", Scrypt, `{"dklen": 64, "n": 2, "r": 8, "p": 1, ` + salt + `}`},
+		{"scrypt n not power of two", Scrypt, `{"dklen": 32, "n": 1000, "r": 8, "p": 1, ` + salt + `}`},
+		{"scrypt n one", Scrypt, `{"dklen": 32, "n": 1, "r": 8, "p": 1, ` + salt + `}`},
+		{"scrypt r zero", Scrypt, `{"dklen": 32, "n": 2, "r": 0, "p": 1, ` + salt + `}`},
+		{"scrypt p zero", Scrypt, `{"dklen": 32, "n": 2, "r": 8, "p": 0, ` + salt + `}`},
+		{"scrypt memory", Scrypt, `{"dklen": 32, "n": 2097152, "r": 8, "p": 1, ` + salt + `}`},
+		{"scrypt work", Scrypt, `{"dklen": 32, "n": 1048576, "r": 8, "p": 5, ` + salt + `}`},
+		{"pbkdf2 iterations", PBKDF2, `{"dklen": 32, "c": 10000001, "prf": "hmac-sha256", ` + salt + `}`},
+		{"pbkdf2 prf", PBKDF2, `{"dklen": 32, "c": 1000, "prf": "hmac-sha512", ` + salt + `}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := fmt.Sprintf(key, tt.kdf, tt.params)
+			if _, _, err := Decrypt([]byte(data), "testpassword"); err != ErrUnsupported {
+				t.Errorf("Decrypt() error = %v, want %v", err, ErrUnsupported)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	seed := bip39.MnemonicToSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	for _, kdf := range []KDF{Scrypt, PBKDF2} {
+		t.Run(string(kdf), func(t *testing.T) {
+			opts := &Options{KDF: kdf, ScryptN: 1 << 10, Iterations: 1000}
+			data, err := Export(seed, "", "secret", opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Contains(data, []byte(`"address": "9858effd232b4033e47d90003d41ec34ecaeda94"`)) {
+				t.Errorf("Export() = %s", data)
+			}
+			priv, address, err := Decrypt(data, "secret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address != "0x9858EfFD232B4033E47d90003D41EC34EcaEda94" {
+				t.Errorf("Decrypt() address = %s", address)
+			}
+			if hex.EncodeToString(priv) != "1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727" {
+				t.Errorf("Decrypt() = %x", priv)
+			}
+
+			tampered := bytes.Replace(data, []byte("9858effd"), []byte("0000effd"), 1)
+			if _, _, err := Decrypt(tampered, "secret"); err != ErrAddressMismatch {
+				t.Errorf("Decrypt() error = %v, want %v", err, ErrAddressMismatch)
+			}
+		})
+	}
+}
+
+func TestAddress(t *testing.T) {
+	priv, _ := hex.DecodeString("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	got, err := Address(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b" {
+		t.Errorf("Address() = %s", got)
+	}
+	if _, err := Address(make([]byte, 32)); err != ErrInvalidKey {
+		t.Errorf("Address() error = %v, want %v", err, ErrInvalidKey)
+	}
+}
@@ -0,0 +1,55 @@
+package bip39
+
+// knownEntropies are the hex entropies of published mnemonics, in any
+// language since a translation has the same entropy
+var knownEntropies = []string{
+	// Trezor test vectors, https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+	"00000000000000000000000000000000",
+	"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+	"80808080808080808080808080808080",
+	"ffffffffffffffffffffffffffffffff",
+	"000000000000000000000000000000000000000000000000",
+	"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+	"808080808080808080808080808080808080808080808080",
+	"ffffffffffffffffffffffffffffffffffffffffffffffff",
+	"0000000000000000000000000000000000000000000000000000000000000000",
+	"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+	"8080808080808080808080808080808080808080808080808080808080808080",
+	"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	"9e885d952ad362caeb4efe34a8e91bd2",
+	"6610b25967cdcca9d59875f5cb50b0ea75433311869e930b",
+	"68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c",
+	"c0ba5a8e914111210f2bd131f3d5e08d",
+	"6d9be1ee6ebd27a258115aad99b7317b9c8d28b6d76431c3",
+	"9f6a2878b2520799a44ef18bc7df394e7061a224d2c33cd015b157d746869863",
+	"23db8160a31d3e0dca3688ed941adbf3",
+	"8197a4a47f0425faeaa69deebc05ca29c0a5b5cc76ceacc0",
+	"066dca1a2bb7e8a1db2832148ce9933eea0f3ac9548d793112d9a95c9407efad",
+	"f30f8c1da665478f49b001d94c5fc452",
+	"c10ec20dc3cd9f652c7fac2f1230f7a3c828389a14392f05",
+	"f585c11aec520db57dd353c69554b21a89b20fb0650966fa0a9d6f74fd989d8f",
+
+	// development tool defaults
+	"df9bf37e6fcdf9bf37e6fcdf9bf37e3c", // Hardhat and Foundry: test test ... junk
+	"92903465e029df56cab416a53b015396", // Ganache deterministic: myth like bonus ... collect
+	"2150f0816c6ad265db4dcacce69b6ef3", // Truffle develop: candy maple cake ... treat
+
+	// test fixtures and examples of this module
+	"0e74b64107f94cc0ccfae6a13dcbec3662154fec67e0e00999c07892597d190a",
+	"126f3c8b10757e43bbfd48d79e861d03",
+	"1578ce68fa99785d7f4229714472f207",
+	"270af15f6e2ee178a10725d5f4d477d8",
+	"2d35c9f3e8808bf52c58f4b7a37cb374",
+	"30313233343536373839616263646566",
+	"4fa1a8bc3e6d80ee1316050e862c1812031493212b7ec3f3bb1b08f168cabeef",
+	"79079bf165e25537e2dce15919440cc4",
+	"8231b4ffb7d7d6b348301f710f82bc76344eba31f105c412c3999a277b868ecc",
+	"823be3d84e6ce7494001d42949f9ce391fe45616",
+	"8e8bf76c330d126d3ba872a96f70af1b96e4b549",
+	"8ee3eba21693fc50b2f70f703880d6a5",
+	"b959eaa03fb1f2064c3f3164985ac984",
+	"bb9f7a1007dc6a384dff23b1c653a70018dda315fa2e7f76a6cdcbf4eb67d085",
+	"c5acdd320818377f48d316f7e6696131",
+	"c87c722854425c303d9cc6e0dfbe6e88c978ad55ee041501c058fb1fdb5faaab",
+	"f1d2bcc7e449e0bb3853fa20166f75fee5ebb471e52fae65a50caacc4bbef4e2",
+}
@@ -1,9 +1,14 @@
 package bip39
 
 import (
+	"fmt"
+	"strings"
 	"sync"
+	"unicode"
 
 	"github.com/islishude/bip39/internal/wordlist"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 )
 
 //go:generate stringer -type=Language
@@ -166,3 +171,103 @@ func (lan Language) mapping() map[string]int64 {
 	}
 	return nil
 }
+
+// LanguageInfo is the metadata of a word list language
+type LanguageInfo struct {
+	// Name is the native name of the language
+	Name string
+	// Tag is the BCP 47 tag of the language
+	Tag language.Tag
+	// Separator joins the words of a mnemonic
+	Separator string
+	// NFKD reports whether the words change under NFKD, such as the accented
+	// words, so the input must be normalized before the lookup
+	NFKD bool
+}
+
+var languageInfos = [...]LanguageInfo{
+	ChineseSimplified:  {Name: "简体中文", Tag: language.MustParse("zh-Hans"), Separator: "\x20"},
+	ChineseTraditional: {Name: "繁體中文", Tag: language.MustParse("zh-Hant"), Separator: "\x20"},
+	English:            {Name: "English", Tag: language.English, Separator: "\x20"},
+	French:             {Name: "Français", Tag: language.French, Separator: "\x20", NFKD: true},
+	Italian:            {Name: "Italiano", Tag: language.Italian, Separator: "\x20"},
+	Japanese:           {Name: "日本語", Tag: language.Japanese, Separator: "　", NFKD: true},
+	Korean:             {Name: "한국어", Tag: language.Korean, Separator: "\x20", NFKD: true},
+	Spanish:            {Name: "Español", Tag: language.Spanish, Separator: "\x20", NFKD: true},
+	Czech:              {Name: "Čeština", Tag: language.Czech, Separator: "\x20"},
+	Portuguese:         {Name: "Português", Tag: language.Portuguese, Separator: "\x20"},
+}
+
+// Languages returns the supported languages
+func Languages() []Language {
+	langs := make([]Language, len(languageInfos))
+	for i := range langs {
+		langs[i] = Language(i)
+	}
+	return langs
+}
+
+// Info returns the metadata of the language, the zero value if unknown
+func (lan Language) Info() LanguageInfo {
+	if lan < 0 || int(lan) >= len(languageInfos) {
+		return LanguageInfo{}
+	}
+	return languageInfos[lan]
+}
+
+var languageMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(languageInfos))
+	for i, info := range languageInfos {
+		tags[i] = info.Tag
+	}
+	return language.NewMatcher(tags)
+}()
+
+// ParseLanguage returns the language of a name, as returned by String or
+// written like "chinese-simplified", a native name such as "日本語", or a
+// BCP 47 tag such as "pt-BR" or "zh-TW"
+func ParseLanguage(s string) (Language, error) {
+	key := languageKey(s)
+	for _, lan := range Languages() {
+		if key == languageKey(lan.String()) || key == languageKey(lan.Info().Name) {
+			return lan, nil
+		}
+	}
+	tag, err := language.Parse(s)
+	if err != nil {
+		return 0, fmt.Errorf("unknown language %q", s)
+	}
+	if _, idx, conf := languageMatcher.Match(tag); conf != language.No {
+		return Language(idx), nil
+	}
+	return 0, fmt.Errorf("unsupported language %q", s)
+}
+
+// languageKey returns the lower case name without separators
+func languageKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == '\x20' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, norm.NFC.String(strings.TrimSpace(s)))
+}
+
+// MarshalText implements encoding.TextMarshaler, the text is the String name
+func (lan Language) MarshalText() ([]byte, error) {
+	if lan.Info().Name == "" {
+		return nil, fmt.Errorf("unknown language %d", int(lan))
+	}
+	return []byte(lan.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the text
+// ParseLanguage does
+func (lan *Language) UnmarshalText(text []byte) error {
+	l, err := ParseLanguage(string(text))
+	if err != nil {
+		return err
+	}
+	*lan = l
+	return nil
+}
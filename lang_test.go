@@ -1,10 +1,13 @@
 package bip39
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
 	"github.com/islishude/bip39/internal/wordlist"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestLanguage_List(t *testing.T) {
@@ -107,3 +110,95 @@ func TestLanguage_mapping(t *testing.T) {
 		})
 	}
 }
+
+func TestLanguages(t *testing.T) {
+	langs := Languages()
+	if len(langs) != 10 || langs[0] != ChineseSimplified || langs[9] != Portuguese {
+		t.Fatalf("Languages() = %v", langs)
+	}
+	for _, lan := range langs {
+		info := lan.Info()
+		if info.Name == "" || info.Tag == language.Und || info.Separator == "" {
+			t.Errorf("%v.Info() = %+v", lan, info)
+		}
+		nfkd := false
+		for _, w := range lan.list() {
+			if norm.NFC.String(w) != w {
+				nfkd = true
+				break
+			}
+		}
+		if info.NFKD != nfkd {
+			t.Errorf("%v.Info().NFKD = %v, want %v", lan, info.NFKD, nfkd)
+		}
+	}
+	if info := Language(100).Info(); info != (LanguageInfo{}) {
+		t.Errorf("Language(100).Info() = %+v", info)
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Language
+		wantErr bool
+	}{
+		{input: "English", want: English},
+		{input: "portuguese", want: Portuguese},
+		{input: "chinese-simplified", want: ChineseSimplified},
+		{input: "ChineseTraditional", want: ChineseTraditional},
+		{input: "日本語", want: Japanese},
+		{input: "español", want: Spanish},
+		{input: "CESTINA", wantErr: true},
+		{input: "Čeština", want: Czech},
+		{input: "en-US", want: English},
+		{input: "pt-BR", want: Portuguese},
+		{input: "zh-Hans", want: ChineseSimplified},
+		{input: "zh-CN", want: ChineseSimplified},
+		{input: "zh-TW", want: ChineseTraditional},
+		{input: "zh-Hant-HK", want: ChineseTraditional},
+		{input: "ko", want: Korean},
+		{input: "de", wantErr: true},
+		{input: "klingon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseLanguage(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLanguage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("ParseLanguage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguage_MarshalText(t *testing.T) {
+	type config struct {
+		Lang  Language   `json:"lang"`
+		Langs []Language `json:"langs"`
+	}
+	data, err := json.Marshal(config{Lang: Portuguese, Langs: []Language{Japanese, ChineseTraditional}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"lang":"Portuguese","langs":["Japanese","ChineseTraditional"]}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got config
+	if err := json.Unmarshal([]byte(`{"lang":"fr","langs":["korean","zh-Hant"]}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if want := (config{Lang: French, Langs: []Language{Korean, ChineseTraditional}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", got, want)
+	}
+
+	if _, err := Language(100).MarshalText(); err == nil {
+		t.Error("MarshalText() accepts an unknown language")
+	}
+	if err := json.Unmarshal([]byte(`{"lang":"elvish"}`), &got); err == nil {
+		t.Error("UnmarshalText() accepts an unknown language")
+	}
+}
@@ -17,7 +17,9 @@ func TestLanguage_String(t *testing.T) {
 		{"Korean", Korean, "Korean"},
 		{"Spanish", Spanish, "Spanish"},
 		{"Czech", Czech, "Czech"},
+		{"Portuguese", Portuguese, "Portuguese"},
 		{"Unknown", 10000, "Language(10000)"},
+		{"Negative", -1, "Language(-1)"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
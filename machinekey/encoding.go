@@ -0,0 +1,98 @@
+package machinekey
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"strings"
+
+	"github.com/islishude/bip39/internal/bech32"
+	"golang.org/x/crypto/curve25519"
+)
+
+const sshKeyType = "ssh-ed25519"
+
+// SSHPublicKey returns the ed25519 public key in the OpenSSH authorized_keys format
+func (k Key) SSHPublicKey(comment string) string {
+	line := sshKeyType + " " + base64.StdEncoding.EncodeToString(k.sshPublicBlob())
+	if comment != "" {
+		line += " " + comment
+	}
+	return line
+}
+
+// SSHPrivateKey returns the unencrypted ed25519 private key in the PEM encoded OpenSSH format.
+// The check integers are taken from sha256 of the public key instead of random,
+// so the output is reproducible.
+func (k Key) SSHPrivateKey(comment string) []byte {
+	priv := k.Ed25519()
+	pub := priv.Public().(ed25519.PublicKey)
+	check := sha256.Sum256(pub)
+
+	var section []byte
+	section = append(section, check[:4]...)
+	section = append(section, check[:4]...)
+	section = appendSSHString(section, []byte(sshKeyType))
+	section = appendSSHString(section, pub)
+	section = appendSSHString(section, priv)
+	section = appendSSHString(section, []byte(comment))
+	for i := byte(1); len(section)%8 != 0; i++ {
+		section = append(section, i)
+	}
+
+	var body []byte
+	body = append(body, "openssh-key-v1\x00"...)
+	body = appendSSHString(body, []byte("none"))
+	body = appendSSHString(body, []byte("none"))
+	body = appendSSHString(body, nil)
+	body = binary.BigEndian.AppendUint32(body, 1)
+	body = appendSSHString(body, k.sshPublicBlob())
+	body = appendSSHString(body, section)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "OPENSSH PRIVATE KEY", Bytes: body})
+}
+
+func (k Key) sshPublicBlob() []byte {
+	pub := k.Ed25519().Public().(ed25519.PublicKey)
+	return appendSSHString(appendSSHString(nil, []byte(sshKeyType)), pub)
+}
+
+func appendSSHString(b, s []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+// AgeIdentity returns the X25519 identity in the age AGE-SECRET-KEY-1 format
+func (k Key) AgeIdentity() string {
+	data, _ := bech32.ConvertBits(k[:], 8, 5, true)
+	return strings.ToUpper(bech32.Encode("age-secret-key-", data, bech32.Bech32))
+}
+
+// AgeRecipient returns the X25519 recipient in the age age1 format
+func (k Key) AgeRecipient() string {
+	pub := k.x25519Public()
+	data, _ := bech32.ConvertBits(pub, 8, 5, true)
+	return bech32.Encode("age", data, bech32.Bech32)
+}
+
+// WireGuardPrivateKey returns the clamped Curve25519 private key in base64 as printed by wg genkey
+func (k Key) WireGuardPrivateKey() string {
+	priv := k
+	priv[0] &= 248
+	priv[31] &= 127
+	priv[31] |= 64
+	return base64.StdEncoding.EncodeToString(priv[:])
+}
+
+// WireGuardPublicKey returns the Curve25519 public key in base64 as printed by wg pubkey
+func (k Key) WireGuardPublicKey() string {
+	return base64.StdEncoding.EncodeToString(k.x25519Public())
+}
+
+func (k Key) x25519Public() []byte {
+	// X25519 with the base point never returns the all-zero output
+	pub, _ := curve25519.X25519(k[:], curve25519.Basepoint)
+	return pub
+}
@@ -0,0 +1,85 @@
+package machinekey
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func testKeyFromSeed(t *testing.T) Key {
+	k, err := FromBIP85(make([]byte, 64), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestKey_SSH(t *testing.T) {
+	k := testKeyFromSeed(t)
+
+	const wantPub = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMZ6JvncDBJce4L83qtrb3Qcz18KWOWFQ3R2hIk3kg1F me@host"
+	if got := k.SSHPublicKey("me@host"); got != wantPub {
+		t.Errorf("SSHPublicKey() = %v, want %v", got, wantPub)
+	}
+
+	pem := k.SSHPrivateKey("me@host")
+	raw, err := ssh.ParseRawPrivateKey(pem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv, ok := raw.(*ed25519.PrivateKey)
+	if !ok || !priv.Equal(k.Ed25519()) {
+		t.Errorf("SSHPrivateKey() parsed = %x, want %x", raw, k.Ed25519())
+	}
+	if string(k.SSHPrivateKey("me@host")) != string(pem) {
+		t.Error("SSHPrivateKey() is not deterministic")
+	}
+
+	pub, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(wantPub))
+	if err != nil || comment != "me@host" {
+		t.Fatal(err)
+	}
+	signer, _ := ssh.NewSignerFromKey(*priv)
+	if string(signer.PublicKey().Marshal()) != string(pub.Marshal()) {
+		t.Error("SSHPublicKey() doesn't match the private key")
+	}
+}
+
+func TestKey_Age(t *testing.T) {
+	k := testKeyFromSeed(t)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"identity", k.AgeIdentity(), "AGE-SECRET-KEY-1X889NEEYCWWYJXHTRA6XJLNVNPRU4A7RP2FQJKEGQ3R7Q4X2C0TSXUPSVM"},
+		{"recipient", k.AgeRecipient(), "age1qwhc23agmv9grp9swqd3ku3hxquvle8z9q4q65fdkk73kk8u69kqrzfceq"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_WireGuard(t *testing.T) {
+	k := testKeyFromSeed(t)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"private", k.WireGuardPrivateKey(), "MM5Z5yTDnEka6x90aX5smEfK98MKkglbKARH4FTKw1c="},
+		{"public", k.WireGuardPublicKey(), "A6+FR6jbCoGEsHAbG3I3MDjP5OIoKg1RLbW9G1j80Ww="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
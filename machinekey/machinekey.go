@@ -0,0 +1,139 @@
+// Package machinekey derives deterministic machine keys from a BIP39 seed and
+// encodes them as OpenSSH, age and WireGuard keys.
+//
+// All keys start from the 64 bytes seed returned by bip39.MnemonicToSeed, so a
+// break-glass credential can be recovered from the paper mnemonic alone.
+// Two derivation schemes are supported:
+//
+// SLIP-0013 and SLIP-0017 paths. The identity URI (e.g. ssh://root@host) and an
+// index are hashed as sha256(le32(index) || uri), and the first 16 bytes are read
+// as four little-endian uint32 values a, b, c and d. SSH keys are derived with the
+// SLIP-0010 ed25519 curve at m/13'/a'/b'/c'/d', age and WireGuard keys with the
+// SLIP-0010 curve25519 curve at m/17'/a'/b'/c'/d'. The 32 bytes private key of the
+// final node is the key.
+//
+// BIP85. The BIP32 master key of the seed is derived at the HEX application path
+// m/83696968'/128169'/32'/index', and the first 32 bytes of
+// HMAC-SHA512("bip-entropy-from-k", k) are the key.
+//
+// The key is used as the ed25519 seed for SSH keys and as the X25519 scalar for
+// age and WireGuard keys.
+package machinekey
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// Curve is the SLIP-0010 curve used by a derivation
+type Curve int
+
+// Curve list
+const (
+	Ed25519 Curve = iota
+	Curve25519
+)
+
+// ErrUnknownCurve is returned for an unsupported curve
+var ErrUnknownCurve = errors.New("machinekey: unknown curve")
+
+// Key is the 32 bytes secret of a machine key
+type Key [32]byte
+
+// FromPath derives a key along a hardened-only SLIP-0010 path like m/13'/1'/2'/3'/4'
+func FromPath(seed []byte, curve Curve, path string) (Key, error) {
+	var curveSeed string
+	switch curve {
+	case Ed25519:
+		curveSeed = hdkey.Ed25519Seed
+	case Curve25519:
+		curveSeed = hdkey.Curve25519Seed
+	default:
+		return Key{}, ErrUnknownCurve
+	}
+
+	p, err := hdkey.ParsePath(path)
+	if err != nil {
+		return Key{}, err
+	}
+	priv, _, err := hdkey.DeriveSLIP10(curveSeed, seed, p)
+	if err != nil {
+		return Key{}, err
+	}
+	var k Key
+	copy(k[:], priv)
+	return k, nil
+}
+
+// FromSLIP13 derives an ed25519 key for SSH at the SLIP-0013 path of uri and index
+func FromSLIP13(seed []byte, uri string, index uint32) (Key, error) {
+	return FromPath(seed, Ed25519, SLIP13Path(uri, index))
+}
+
+// FromSLIP17 derives a curve25519 key for age or WireGuard at the SLIP-0017 path of uri and index
+func FromSLIP17(seed []byte, uri string, index uint32) (Key, error) {
+	return FromPath(seed, Curve25519, SLIP17Path(uri, index))
+}
+
+// FromBIP85 derives a key with the BIP85 HEX application at m/83696968'/128169'/32'/index'
+func FromBIP85(seed []byte, index uint32) (Key, error) {
+	master, err := hdkey.NewMaster(seed)
+	if err != nil {
+		return Key{}, err
+	}
+	entropy, err := bip85Entropy(master, []uint32{
+		83696968 + hdkey.Hardened,
+		128169 + hdkey.Hardened,
+		32 + hdkey.Hardened,
+		index | hdkey.Hardened,
+	})
+	if err != nil {
+		return Key{}, err
+	}
+	var k Key
+	copy(k[:], entropy)
+	return k, nil
+}
+
+// SLIP13Path returns the SLIP-0013 authentication path of uri and index
+func SLIP13Path(uri string, index uint32) string {
+	return identityPath(13, uri, index)
+}
+
+// SLIP17Path returns the SLIP-0017 ECDH path of uri and index
+func SLIP17Path(uri string, index uint32) string {
+	return identityPath(17, uri, index)
+}
+
+func identityPath(purpose uint32, uri string, index uint32) string {
+	data := binary.LittleEndian.AppendUint32(nil, index)
+	hash := sha256.Sum256(append(data, uri...))
+
+	path := []uint32{purpose | hdkey.Hardened}
+	for i := 0; i < 16; i += 4 {
+		path = append(path, binary.LittleEndian.Uint32(hash[i:i+4])|hdkey.Hardened)
+	}
+	return hdkey.FormatPath(path)
+}
+
+// bip85Entropy returns 64 bytes BIP85 entropy of the path
+func bip85Entropy(master *hdkey.Key, path []uint32) ([]byte, error) {
+	child, err := master.Derive(path)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha512.New, []byte("bip-entropy-from-k"))
+	_, _ = mac.Write(child.PrivateKey())
+	return mac.Sum(nil), nil
+}
+
+// Ed25519 returns the ed25519 private key using k as the seed
+func (k Key) Ed25519() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k[:])
+}
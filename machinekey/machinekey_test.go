@@ -0,0 +1,100 @@
+package machinekey
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+const testMnemonic = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+
+func Test_bip85Entropy(t *testing.T) {
+	// BIP85 test vectors
+	master, err := hdkey.Parse("xprv9s21ZrQH143K2LBWUUQRFXhucrQqBpKdRRxNVq2zBqsx8HVqFk2uYo8kmbaLLHRdqtQpUm98uKfu3vca1LqdGhUtyoFnCNkfmXRyPXLjbKb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "test case 1",
+			path: "m/83696968'/0'/0'",
+			want: "efecfbccffea313214232d29e71563d941229afb4338c21f9517c41aaa0d16f00b83d2a09ef747e7a64e8e2bd5a14869e693da66ce94ac2da570ab7ee48618f7",
+		},
+		{
+			name: "hex application",
+			path: "m/83696968'/128169'/64'/0'",
+			want: "492db4698cf3b73a5a24998aa3e9d7fa96275d85724a91e71aa2d645442f878555d078fd1f1f67e368976f04137b1f7a0d19232136ca50c44614af72b5582a5c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, _ := hdkey.ParsePath(tt.path)
+			got, err := bip85Entropy(master, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("bip85Entropy() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSLIP13Path(t *testing.T) {
+	// SLIP-0013 example
+	const want = "m/13'/490267344'/697598796'/1613620211'/1858012177'"
+	if got := SLIP13Path("https://satoshi@bitcoin.org/login", 0); got != want {
+		t.Errorf("SLIP13Path() = %v, want %v", got, want)
+	}
+	if got := SLIP17Path("https://satoshi@bitcoin.org/login", 0); got != "m/17"+want[4:] {
+		t.Errorf("SLIP17Path() = %v, want %v", got, "m/17"+want[4:])
+	}
+}
+
+func TestDerive(t *testing.T) {
+	seed := bip39.MnemonicToSeed(testMnemonic, "")
+	tests := []struct {
+		name   string
+		derive func() (Key, error)
+		want   string
+	}{
+		{
+			name:   "slip13",
+			derive: func() (Key, error) { return FromSLIP13(seed, "ssh://root@bastion", 0) },
+			want:   "7868e65bc20237db0abb39f3fa048df1a851d587a188cfe3c6910b8b15346ca9",
+		},
+		{
+			name:   "slip17",
+			derive: func() (Key, error) { return FromSLIP17(seed, "wireguard://bastion", 0) },
+			want:   "fc2468df991192d549f1ceebbcf9a46124c511e1083b26f50c01af28e9ad0539",
+		},
+		{
+			name:   "bip85",
+			derive: func() (Key, error) { return FromBIP85(seed, 0) },
+			want:   "7c1e164199aaf7b94021081d4ef34434ae6d856c383e9e52411047c25b8884d0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.derive()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got[:]) != tt.want {
+				t.Errorf("derive() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := FromPath(seed, Curve(2), "m/0'"); err != ErrUnknownCurve {
+		t.Errorf("FromPath() error = %v, want %v", err, ErrUnknownCurve)
+	}
+	if _, err := FromPath(seed, Ed25519, "m/0"); err != hdkey.ErrNotHardenedPath {
+		t.Errorf("FromPath() error = %v, want %v", err, hdkey.ErrNotHardenedPath)
+	}
+}
@@ -0,0 +1,175 @@
+// Package metamask decrypts MetaMask extension vaults offline to recover the
+// mnemonic of their HD keyring.
+//
+// A vault is the JSON of a base64 AES-GCM ciphertext, its 16 bytes iv and the
+// PBKDF2-SHA256 salt of the password. The plain text is the JSON list of the
+// serialized keyrings.
+package metamask
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/islishude/bip39"
+)
+
+// HDKeyring is the type of the BIP39 keyring
+const HDKeyring = "HD Key Tree"
+
+// legacyIterations is the PBKDF2 cost of vaults without key metadata
+const legacyIterations = 10000
+
+// maxIterations bounds the PBKDF2 cost of a vault
+const maxIterations = 10_000_000
+
+// Error list
+var (
+	ErrInvalidVault = errors.New("metamask: invalid vault")
+	ErrDecrypt      = errors.New("metamask: wrong password or corrupted vault")
+	ErrNoMnemonic   = errors.New("metamask: no HD keyring in the vault")
+)
+
+// Vault is an encrypted MetaMask vault
+type Vault struct {
+	Data        string       `json:"data"`
+	IV          string       `json:"iv"`
+	Salt        string       `json:"salt"`
+	KeyMetadata *KeyMetadata `json:"keyMetadata,omitempty"`
+}
+
+// KeyMetadata is the key derivation of recent vaults
+type KeyMetadata struct {
+	Algorithm string `json:"algorithm"`
+	Params    struct {
+		Iterations int `json:"iterations"`
+	} `json:"params"`
+}
+
+// Keyring is a decrypted keyring, Data depends on its Type
+type Keyring struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ParseVault parses the vault JSON. The extension state holding the vault
+// under KeyringController, as found in the extension storage or a state
+// log, is accepted too.
+func ParseVault(data []byte) (*Vault, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, ErrInvalidVault
+	}
+	for _, state := range []json.RawMessage{data, fields["data"]} {
+		var s struct {
+			KeyringController *struct {
+				Vault string `json:"vault"`
+			} `json:"KeyringController"`
+		}
+		if json.Unmarshal(state, &s) == nil && s.KeyringController != nil {
+			return ParseVault([]byte(s.KeyringController.Vault))
+		}
+	}
+
+	var v Vault
+	if err := json.Unmarshal(data, &v); err != nil || v.Data == "" || v.IV == "" || v.Salt == "" {
+		return nil, ErrInvalidVault
+	}
+	return &v, nil
+}
+
+// Decrypt returns the keyrings of the vault
+func (v *Vault) Decrypt(password string) ([]Keyring, error) {
+	data, err1 := base64.StdEncoding.DecodeString(v.Data)
+	iv, err2 := base64.StdEncoding.DecodeString(v.IV)
+	salt, err3 := base64.StdEncoding.DecodeString(v.Salt)
+	if err := errors.Join(err1, err2, err3); err != nil || len(iv) == 0 {
+		return nil, ErrInvalidVault
+	}
+
+	iterations := legacyIterations
+	if m := v.KeyMetadata; m != nil {
+		if m.Algorithm != "PBKDF2" || m.Params.Iterations <= 0 || m.Params.Iterations > maxIterations {
+			return nil, ErrInvalidVault
+		}
+		iterations = m.Params.Iterations
+	}
+	key := pbkdf2.Key([]byte(password), salt, iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, iv, data, nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	var keyrings []Keyring
+	if err := json.Unmarshal(plain, &keyrings); err != nil {
+		return nil, ErrInvalidVault
+	}
+	return keyrings, nil
+}
+
+// Mnemonic returns the validated English mnemonic of an HD keyring
+func (k *Keyring) Mnemonic() (string, error) {
+	if k.Type != HDKeyring {
+		return "", ErrNoMnemonic
+	}
+	var data struct {
+		Mnemonic json.RawMessage `json:"mnemonic"`
+	}
+	if err := json.Unmarshal(k.Data, &data); err != nil {
+		return "", ErrInvalidVault
+	}
+	// older keyrings store a string, newer ones the UTF-8 bytes
+	var mnemonic string
+	if err := json.Unmarshal(data.Mnemonic, &mnemonic); err != nil {
+		var raw []byte
+		var numbers []int
+		if err := json.Unmarshal(data.Mnemonic, &numbers); err != nil {
+			return "", ErrInvalidVault
+		}
+		for _, n := range numbers {
+			if n < 0 || n > 255 {
+				return "", ErrInvalidVault
+			}
+			raw = append(raw, byte(n))
+		}
+		mnemonic = string(raw)
+	}
+	mnemonic = strings.Join(strings.Fields(mnemonic), "\x20")
+	if err := bip39.CheckMnemonic(mnemonic, bip39.English); err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+// Mnemonic decrypts the vault and returns the mnemonic of its first HD
+// keyring
+func Mnemonic(vault []byte, password string) (string, error) {
+	v, err := ParseVault(vault)
+	if err != nil {
+		return "", err
+	}
+	keyrings, err := v.Decrypt(password)
+	if err != nil {
+		return "", err
+	}
+	for _, k := range keyrings {
+		if k.Type == HDKeyring {
+			return k.Mnemonic()
+		}
+	}
+	return "", ErrNoMnemonic
+}
@@ -0,0 +1,141 @@
+package metamask
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/islishude/bip39"
+)
+
+const mnemonic = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+
+// seal encrypts the keyrings as the extension does
+func seal(t *testing.T, password, keyrings string, iterations int) []byte {
+	t.Helper()
+	salt, iv := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 16)
+	n := iterations
+	if n == 0 {
+		n = legacyIterations
+	}
+	block, _ := aes.NewCipher(pbkdf2.Key([]byte(password), salt, n, 32, sha256.New))
+	gcm, _ := cipher.NewGCMWithNonceSize(block, len(iv))
+	v := Vault{
+		Data: base64.StdEncoding.EncodeToString(gcm.Seal(nil, iv, []byte(keyrings), nil)),
+		IV:   base64.StdEncoding.EncodeToString(iv),
+		Salt: base64.StdEncoding.EncodeToString(salt),
+	}
+	if iterations != 0 {
+		v.KeyMetadata = &KeyMetadata{Algorithm: "PBKDF2"}
+		v.KeyMetadata.Params.Iterations = iterations
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Fixture vaults written by the browser-passworder encrypt path on WebCrypto, as the
+// extension does: a 32 bytes salt, a 16 bytes iv and the JSON of the keyrings,
+// with the password "correct horse battery staple"
+const (
+	// legacy vault with the default 10000 iterations and a string mnemonic
+	legacyVault = `{"data":"YsHi1DpVCI0khHDztjml6mXKnD92+4UgygqF+bXe7R241IEl1+ycloRf3oEQzFfRm/su7hqYimJ4K3lDK44+o+rI7iJ4l9Y4zWn7NeaHNwfPAd9+bzWwLwKvVwnyseHkIpGCw4srDryFK/RvB3MNceqWX/1LvO4aulBVYZStwPFAdyylLFQYqLC/MAxV7HutEeq+CmAvneolMMHCkagBtISiiLLhexNJD/mRLF9MR9ZrbYcctjRmwKs=","iv":"bb5bR4u1eN9AnY/uhIBkjQ==","salt":"Zql4Jm0PhqTOC8gf/XClLiLJQ1Qoeth6C+2XNR2C0fM="}`
+	// recent vault with the key metadata and the mnemonic as UTF-8 bytes
+	recentVault = `{"data":"rHd/2QQeqS8V2Jv86nl0jkYN+6azEpbxhBEiCkZrDkm5QVeI3892rm55OITM/u07tQXAXrV2mp2f5DvgaHS1Rm95SlVnKsA5wgftom9DztYzELg/w9KYMCxmhfToSSvceXe5KluAeX9hIGG3ABYx8Rit4Ct49a5M62GpUttxSyjOCWgY5x94FdOZ0jyxiW8FghLvIFpfBQMdZSN0iwXs81+/YHXdTJT14kIc3NY0TESAnq1FSQF5SRRD8xq1cKIwgSe4ywn1+frQzsgybOiPzU8/hjwj39t75WRbEiT9H9Q1JNwzlDdgewJokDeuFdl3ibcjjpaww5VOjZNtfuIUWcaWnD+T7wEeGli+0DSug87tqdII0ReK5oqtsbPPX6PJQbchQUx+X3GP3yMjMhdPUKHB/zpXkS687abFzPgscaB813Yq6ccd708nawtoehqUpOdzXq0Ahk1ED56wS6uOn3S/hoy1skkebf7vvOuoQWVfom7v28jzsVaB2kOVLkqtlQKrCXAeNL0jqWGhRafqOfcdIQvWxNoT5Qtha6tyu4GgTvmd3P6XdTkh4kCsvw1/gw==","iv":"5Tv2Nxqu2YDf0qkQobGYtQ==","keyMetadata":{"algorithm":"PBKDF2","params":{"iterations":600000}},"salt":"L78ll4FpwZE1bHghHvdg7O+CMuihCQm+mj3kVb8BBOE="}`
+)
+
+func TestMnemonic_Fixtures(t *testing.T) {
+	tests := []struct {
+		name  string
+		vault string
+		want  string
+	}{
+		{"legacy 10000 iterations", legacyVault, "legal winner thank year wave sausage worth useful legal winner thank yellow"},
+		{"key metadata 600000 iterations", recentVault, "letter advice cage absurd amount doctor acoustic avoid letter advice cage above"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mnemonic([]byte(tt.vault), "correct horse battery staple")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Mnemonic() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	v, err := ParseVault([]byte(recentVault))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.KeyMetadata == nil || v.KeyMetadata.Params.Iterations != 600000 {
+		t.Errorf("ParseVault() = %+v", v)
+	}
+}
+
+func TestMnemonic(t *testing.T) {
+	var numbers []int
+	for _, c := range []byte(mnemonic) {
+		numbers = append(numbers, int(c))
+	}
+	utf8, _ := json.Marshal(numbers)
+
+	legacy := seal(t, "hunter22", fmt.Sprintf(`[{"type":"HD Key Tree","data":{"mnemonic":%q,"numberOfAccounts":1,"hdPath":"m/44'/60'/0'/0"}}]`, mnemonic), 0)
+	recent := seal(t, "hunter22", fmt.Sprintf(`[{"type":"Simple Key Pair","data":[]},{"type":"HD Key Tree","data":{"mnemonic":%s,"numberOfAccounts":2,"hdPath":"m/44'/60'/0'/0"}}]`, utf8), 600000)
+	state, _ := json.Marshal(map[string]any{"data": map[string]any{"KeyringController": map[string]string{"vault": string(legacy)}}})
+
+	tests := []struct {
+		name  string
+		vault []byte
+	}{
+		{"legacy string mnemonic", legacy},
+		{"utf8 bytes mnemonic", recent},
+		{"extension state", state},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mnemonic(tt.vault, "hunter22")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != mnemonic {
+				t.Errorf("Mnemonic() = %s, want %s", got, mnemonic)
+			}
+			if _, err := Mnemonic(tt.vault, "hunter23"); err != ErrDecrypt {
+				t.Errorf("Mnemonic() error = %v, want %v", err, ErrDecrypt)
+			}
+		})
+	}
+}
+
+func TestMnemonicError(t *testing.T) {
+	tests := []struct {
+		name  string
+		vault []byte
+		want  error
+	}{
+		{"no hd keyring", seal(t, "pw", `[{"type":"Simple Key Pair","data":["4af1"]}]`, 0), ErrNoMnemonic},
+		{"checksum", seal(t, "pw", `[{"type":"HD Key Tree","data":{"mnemonic":"check fiscal fit sword unlock rough lottery tool sting pluck bulb bulb"}}]`, 0), bip39.ErrChecksumIncorrect},
+		{"not json", []byte("vault"), ErrInvalidVault},
+		{"missing iv", []byte(`{"data":"AAAA","salt":"AAAA"}`), ErrInvalidVault},
+		{"plain text", seal(t, "pw", `not keyrings`, 0), ErrInvalidVault},
+		{"costly", []byte(`{"data":"AAAA","iv":"AAAA","salt":"AAAA","keyMetadata":{"algorithm":"PBKDF2","params":{"iterations":10000001}}}`), ErrInvalidVault},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Mnemonic(tt.vault, "pw"); err != tt.want {
+				t.Errorf("Mnemonic() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
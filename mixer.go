@@ -0,0 +1,48 @@
+package bip39
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// ErrNoSource is returned by a Mixer without entropy source
+var ErrNoSource = errors.New("mixer has no entropy source")
+
+// Mixer is an entropy source combining several sources, such as crypto/rand,
+// /dev/hwrng or dice rolls stretched by a DRBG. Every Read takes as many
+// bytes from every source as it returns and hashes them with HKDF-SHA512,
+// so the output is no weaker than the strongest source, even if the other
+// ones are broken or malicious.
+type Mixer struct {
+	sources []io.Reader
+}
+
+// NewMixer returns a mixer of the sources
+func NewMixer(sources ...io.Reader) *Mixer {
+	return &Mixer{sources: sources}
+}
+
+// Read fills p with the hash of the sources, it fails if any source does,
+// so a source can't silently drop out of the mix
+func (m *Mixer) Read(p []byte) (int, error) {
+	if len(m.sources) == 0 {
+		return 0, ErrNoSource
+	}
+	input := make([]byte, 0, len(m.sources)*(len(p)+8))
+	chunk := make([]byte, len(p))
+	for i, source := range m.sources {
+		if _, err := io.ReadFull(source, chunk); err != nil {
+			return 0, fmt.Errorf("entropy source %d: %w", i, err)
+		}
+		// the index and the length keep the sources apart
+		input = binary.BigEndian.AppendUint32(input, uint32(i))
+		input = binary.BigEndian.AppendUint32(input, uint32(len(chunk)))
+		input = append(input, chunk...)
+	}
+	return io.ReadFull(hkdf.New(sha512.New, input, nil, []byte("bip39 entropy mixer")), p)
+}
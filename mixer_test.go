@@ -0,0 +1,54 @@
+package bip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestMixer(t *testing.T) {
+	dice := NewDRBG([]byte("3162554213465236615243"), nil, nil)
+	m, err := NewMnemonicWithOptions(24, English, MnemonicOptions{Rand: NewMixer(rand.Reader, dice)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsMnemonicValid(m, English) {
+		t.Errorf("NewMnemonicWithOptions() = %v is invalid", m)
+	}
+
+	// deterministic sources make a deterministic mix which differs from them
+	mix := func(sources ...io.Reader) []byte {
+		out := make([]byte, 32)
+		if _, err := NewMixer(sources...).Read(out); err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+	zeros := bytes.NewReader(make([]byte, 32))
+	a := mix(NewDRBG([]byte("a"), nil, nil), zeros)
+	b := mix(NewDRBG([]byte("a"), nil, nil), bytes.NewReader(make([]byte, 32)))
+	c := mix(NewDRBG([]byte("b"), nil, nil), bytes.NewReader(make([]byte, 32)))
+	if !bytes.Equal(a, b) || bytes.Equal(a, c) || bytes.Equal(a, make([]byte, 32)) {
+		t.Errorf("Mixer.Read() = %x, %x, %x", a, b, c)
+	}
+	// the order of the sources matters
+	d := mix(bytes.NewReader(make([]byte, 32)), NewDRBG([]byte("a"), nil, nil))
+	if bytes.Equal(a, d) {
+		t.Error("Mixer.Read() ignores the order of the sources")
+	}
+}
+
+func TestMixerError(t *testing.T) {
+	if _, err := NewMixer().Read(make([]byte, 16)); err != ErrNoSource {
+		t.Errorf("Mixer.Read() error = %v, want %v", err, ErrNoSource)
+	}
+	short := NewMixer(rand.Reader, bytes.NewReader(make([]byte, 8)))
+	if _, err := short.Read(make([]byte, 16)); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Mixer.Read() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if _, err := NewMnemonicWithOptions(12, English, MnemonicOptions{Rand: short}); err == nil {
+		t.Error("NewMnemonicWithOptions() ignores a failing source")
+	}
+}
@@ -15,14 +15,24 @@ func IsMnemonicValid(m string, lg Language) bool {
 }
 
 // CheckMnemonic creates entropy from mnemonic
+// it returns ErrAezeed for an invalid English mnemonic which is an aezeed
 func CheckMnemonic(mnemonic string, lg Language) error {
+	_, err := MnemonicToEntropy(mnemonic, lg)
+	if err != nil && lg == English && isAezeed(mnemonic) {
+		return ErrAezeed
+	}
+	return err
+}
+
+// MnemonicToEntropy validates mnemonic and returns its entropy
+func MnemonicToEntropy(mnemonic string, lg Language) ([]byte, error) {
 	mnemonic = norm.NFKD.String(mnemonic)
 	wordList := strings.Split(mnemonic, "\x20")
 
 	wordCount := len(wordList)
 	// invalid word list length
 	if wordCount%3 != 0 || wordCount < 12 || wordCount > 24 {
-		return ErrWordLen
+		return nil, ErrWordLen
 	}
 
 	entBig := new(big.Int)
@@ -31,7 +41,7 @@ func CheckMnemonic(mnemonic string, lg Language) error {
 		idx, ok := mapping[word]
 		// not includes the word
 		if !ok {
-			return fmt.Errorf("word `%s` at `%d` not found in mnemonic mapping", word, wordIdx)
+			return nil, fmt.Errorf("word `%s` at `%d` not found in mnemonic mapping", word, wordIdx)
 		}
 
 		partBig := big.NewInt(int64(idx))
@@ -43,8 +53,8 @@ func CheckMnemonic(mnemonic string, lg Language) error {
 	// get checksum
 	csBig := new(big.Int).And(entBig, big.NewInt(shift-1))
 
-	// get real entropy
-	entBytes := entBig.Quo(entBig, big.NewInt(shift)).Bytes()
+	// get real entropy, keep the leading zero bytes
+	entBytes := entBig.Quo(entBig, big.NewInt(shift)).FillBytes(make([]byte, wordCount/3*4))
 	// get checksum from real entropy
 	hash := sha256.New()
 	_, _ = hash.Write(entBytes)
@@ -53,7 +63,7 @@ func CheckMnemonic(mnemonic string, lg Language) error {
 
 	// compare checksum
 	if sum.Cmp(csBig) != 0 {
-		return ErrChecksumIncorrect
+		return nil, ErrChecksumIncorrect
 	}
-	return nil
+	return entBytes, nil
 }
@@ -1,6 +1,9 @@
 package bip39
 
-import "testing"
+import (
+	"encoding/hex"
+	"testing"
+)
 
 func TestIsMnemonicValid(t *testing.T) {
 	type args struct {
@@ -28,6 +31,14 @@ func TestIsMnemonicValid(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "English with leading zero entropy",
+			args: args{
+				mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+				lang:     English,
+			},
+			want: true,
+		},
 		{
 			name: "EnglishValidLength",
 			args: args{
@@ -133,3 +144,56 @@ func TestIsMnemonicValid(t *testing.T) {
 		})
 	}
 }
+
+func TestMnemonicToEntropy(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		lang     Language
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "zero entropy",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			lang:     English,
+			want:     "00000000000000000000000000000000",
+		},
+		{
+			name:     "15 words",
+			mnemonic: "model garden swallow gravity spell custom upgrade atom practice knee cloth damp hour follow category",
+			lang:     English,
+			want:     "8e8bf76c330d126d3ba872a96f70af1b96e4b549",
+		},
+		{
+			name:     "japanese",
+			mnemonic: "そらまめ　ほとんど　らくがき　ていか　ひみつ　てんらんかい　あいこくしん　くうふく　かいほう　こさめ　せいかつ　すめし　ろれつ　かたい　さつえい",
+			lang:     Japanese,
+			want:     "823be3d84e6ce7494001d42949f9ce391fe45616",
+		},
+		{
+			name:     "checksum error",
+			mnemonic: "ivory disorder hawk slot oil promote north fat zebra useless device cargo",
+			lang:     English,
+			wantErr:  ErrChecksumIncorrect,
+		},
+		{
+			name:     "invalid length",
+			mnemonic: "rich soon pool",
+			lang:     English,
+			wantErr:  ErrWordLen,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MnemonicToEntropy(tt.mnemonic, tt.lang)
+			if err != tt.wantErr {
+				t.Errorf("MnemonicToEntropy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("MnemonicToEntropy() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package bip39
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrNotCanonical is returned in strict mode for a valid mnemonic which is
+// not written with single separators of its language
+var ErrNotCanonical = errors.New("mnemonic is not in canonical form")
+
+// ParseOptions are the ParseMnemonic options
+type ParseOptions struct {
+	// Strict accepts the canonical sentence only, as CheckMnemonic does
+	Strict bool
+	// FoldAccents matches the words typed without their accents, such as
+	// "accion" for "acción", in Spanish, French, Portuguese and Czech.
+	// It is ignored in strict mode.
+	FoldAccents bool
+}
+
+// ParseMnemonic validates a mnemonic pasted from real world sources and
+// returns its canonical sentence: lower case words of the word list joined
+// by the language separator, an ideographic space for Japanese and a space
+// for the others.
+//
+// Unless in strict mode, any run of whitespace, commas or semicolons
+// separates words, case is ignored and list numbering such as "1." or "2)"
+// is dropped. Japanese words may be typed in katakana or Hepburn romaji,
+// such as "アイコクシン" or "aikokushin" for "あいこくしん". Chinese
+// characters need no separator, ErrChineseScript is returned for characters
+// of the other Chinese list.
+func ParseMnemonic(input string, lang Language, opts ParseOptions) (string, error) {
+	mnemonic := input
+	if !opts.Strict {
+		words := splitWords(input)
+		if _, ok := lang.chinese(); ok {
+			words = splitHan(words)
+		}
+		for i, w := range words {
+			switch {
+			case lang == Japanese:
+				words[i] = japaneseWord(w)
+			case opts.FoldAccents:
+				words[i] = lang.foldWord(w)
+			}
+		}
+		if err := chineseScriptError(words, lang); err != nil {
+			return "", err
+		}
+		mnemonic = strings.Join(words, "\x20")
+	}
+	entropy, err := MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return "", err
+	}
+	canonical := fromEntropy(entropy, len(entropy)/4*3, lang)
+	if opts.Strict && !sameSentence(input, canonical, lang) {
+		return "", ErrNotCanonical
+	}
+	return canonical, nil
+}
+
+// sameSentence reports whether the input has the words of the canonical
+// sentence, in any normalization form, joined by the language separator
+func sameSentence(input, canonical string, lang Language) bool {
+	sep := lang.Info().Separator
+	got, want := strings.Split(input, sep), strings.Split(canonical, sep)
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if norm.NFKD.String(got[i]) != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitWords returns the lower case words of the input without numbering
+func splitWords(input string) []string {
+	fields := strings.FieldsFunc(norm.NFKD.String(input), func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(",;，、；", r)
+	})
+	words := make([]string, 0, len(fields))
+	for _, f := range fields {
+		// "1.", "2)" or "3:abandon", a number alone is a list item
+		word := strings.TrimLeftFunc(f, unicode.IsDigit)
+		if word != f {
+			word = strings.TrimLeft(word, ".):-#")
+		}
+		word = strings.TrimLeft(word, "#")
+		if word == "" {
+			continue
+		}
+		words = append(words, strings.ToLower(word))
+	}
+	return words
+}
@@ -0,0 +1,95 @@
+package bip39
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestParseMnemonic(t *testing.T) {
+	const english = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+	tests := []struct {
+		name    string
+		input   string
+		lang    Language
+		opts    ParseOptions
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "canonical",
+			input: english,
+			lang:  English,
+			want:  english,
+		},
+		{
+			name:  "whitespace and case",
+			input: "  Check\tFISCAL  fit\nsword unlock\r\nrough lottery tool sting pluck bulb random \n",
+			lang:  English,
+			want:  english,
+		},
+		{
+			name:  "numbered list",
+			input: "1. check 2. fiscal 3. fit 4. sword 5. unlock 6. rough\n7) lottery 8) tool 9) sting 10) pluck 11:bulb 12:random",
+			lang:  English,
+			want:  english,
+		},
+		{
+			name:  "commas",
+			input: "check, fiscal, fit, sword, unlock, rough, lottery, tool, sting, pluck, bulb, random",
+			lang:  English,
+			want:  english,
+		},
+		{
+			name:  "japanese ascii spaces",
+			input: "ねほりはほり ひらがな とさか そつう おうじ あてな きくらげ みもと してつ ぱそこん にってい いこつ",
+			lang:  Japanese,
+			want:  "ねほりはほり　ひらがな　とさか　そつう　おうじ　あてな　きくらげ　みもと　してつ　ぱそこん　にってい　いこつ",
+		},
+		{
+			name:  "french",
+			input: "Pieuvre Revivre Nuptial Implorer Blinder Accroche Chute Syntaxe Félin Promener Parcelle Aimable",
+			lang:  French,
+			want:  "pieuvre revivre nuptial implorer blinder accroche chute syntaxe félin promener parcelle aimable",
+		},
+		{
+			name:  "strict canonical",
+			input: english,
+			lang:  English,
+			opts:  ParseOptions{Strict: true},
+			want:  english,
+		},
+		{
+			name:    "strict double space",
+			input:   "check  fiscal fit sword unlock rough lottery tool sting pluck bulb random",
+			lang:    English,
+			opts:    ParseOptions{Strict: true},
+			wantErr: ErrWordLen,
+		},
+		{
+			name:    "strict japanese ascii spaces",
+			input:   "ねほりはほり ひらがな とさか そつう おうじ あてな きくらげ みもと してつ ぱそこん にってい いこつ",
+			lang:    Japanese,
+			opts:    ParseOptions{Strict: true},
+			wantErr: ErrNotCanonical,
+		},
+		{
+			name:    "checksum",
+			input:   "1. check 2. fiscal 3. fit 4. sword 5. unlock 6. rough 7. lottery 8. tool 9. sting 10. pluck 11. bulb 12. bulb",
+			lang:    English,
+			wantErr: ErrChecksumIncorrect,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMnemonic(tt.input, tt.lang, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("ParseMnemonic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// the words of the list are decomposed, NFD keeps the U+3000 separator
+			if err == nil && got != norm.NFD.String(tt.want) {
+				t.Errorf("ParseMnemonic() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package bip39
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// AmbiguousPrefixError is returned for prefixes matching several words
+type AmbiguousPrefixError struct {
+	// Positions are the indexes of the ambiguous words
+	Positions []int
+	// Candidates are the words matching the prefix at each position
+	Candidates [][]string
+}
+
+func (e *AmbiguousPrefixError) Error() string {
+	positions := make([]string, len(e.Positions))
+	for i, p := range e.Positions {
+		positions[i] = strconv.Itoa(p)
+	}
+	return "ambiguous prefix at " + strings.Join(positions, ", ")
+}
+
+// maxPrefixCombinations bounds the checksum search over the ambiguous prefixes
+const maxPrefixCombinations = 1 << 12
+
+// ExpandPrefixes validates a mnemonic written with word prefixes, such as
+// the first four letters of metal plate backups, and returns its canonical
+// sentence. A prefix matches the words it begins, accents may be omitted in
+// Spanish, French, Portuguese and Czech. A word shorter than the longest
+// prefix is taken as written, as the other words it begins would have been
+// written longer. When several words match, the checksum settles the one
+// valid sentence, or an *AmbiguousPrefixError reports the positions.
+func ExpandPrefixes(input string, lang Language) (string, error) {
+	prefixes := splitWords(input)
+	if n := len(prefixes); n%3 != 0 || n < 12 || n > 24 {
+		return "", ErrWordLen
+	}
+	words, err := lang.expandPrefixes(prefixes)
+	if err != nil {
+		return "", err
+	}
+	return ParseMnemonic(strings.Join(words, "\x20"), lang, ParseOptions{})
+}
+
+// PrefixForm returns the first n characters of every word of a mnemonic, or
+// the whole words shorter than that, separated by the language separator.
+// An *AmbiguousPrefixError is returned if ExpandPrefixes can't expand them
+// back to the mnemonic.
+func PrefixForm(mnemonic string, lang Language, n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("invalid prefix length %d", n)
+	}
+	entropy, err := MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return "", err
+	}
+	sep := lang.Info().Separator
+	words := strings.Split(fromEntropy(entropy, len(entropy)/4*3, lang), sep)
+	prefixes := make([]string, len(words))
+	for i, w := range words {
+		if r := []rune(norm.NFC.String(w)); len(r) > n {
+			prefixes[i] = string(r[:n])
+		} else {
+			prefixes[i] = string(r)
+		}
+	}
+	// the original sentence is valid, so the expansion is either it or ambiguous
+	if _, err := lang.expandPrefixes(prefixes); err != nil {
+		return "", err
+	}
+	return strings.Join(prefixes, sep), nil
+}
+
+// expandPrefixes returns the words of the prefixes, the ambiguous prefixes are
+// settled by the checksum if a single sentence is valid
+func (lan Language) expandPrefixes(prefixes []string) ([]string, error) {
+	var longest int
+	for _, p := range prefixes {
+		longest = max(longest, utf8.RuneCountInString(norm.NFC.String(p)))
+	}
+	words := make([]string, len(prefixes))
+	var ambiguous AmbiguousPrefixError
+	combinations := 1
+	for i, p := range prefixes {
+		matches := lan.prefixMatches(p, utf8.RuneCountInString(norm.NFC.String(p)) < longest)
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("prefix `%s` at `%d` not found in mnemonic mapping", p, i)
+		case 1:
+			words[i] = matches[0]
+		default:
+			ambiguous.Positions = append(ambiguous.Positions, i)
+			ambiguous.Candidates = append(ambiguous.Candidates, matches)
+			combinations = min(combinations*len(matches), maxPrefixCombinations+1)
+		}
+	}
+	if len(ambiguous.Positions) == 0 {
+		return words, nil
+	}
+	if combinations <= maxPrefixCombinations && lan.settlePrefixes(words, &ambiguous) {
+		return words, nil
+	}
+	return nil, &ambiguous
+}
+
+// settlePrefixes fills the ambiguous positions of words if exactly one choice
+// of the candidates passes the checksum
+func (lan Language) settlePrefixes(words []string, ambiguous *AmbiguousPrefixError) bool {
+	choice := make([]int, len(ambiguous.Positions))
+	var found []string
+	for {
+		for i, p := range ambiguous.Positions {
+			words[p] = ambiguous.Candidates[i][choice[i]]
+		}
+		if _, err := MnemonicToEntropy(strings.Join(words, "\x20"), lan); err == nil {
+			if found != nil {
+				return false
+			}
+			found = append([]string{}, words...)
+		}
+		// next choice, the last position varies fastest
+		i := len(choice) - 1
+		for ; i >= 0; i-- {
+			if choice[i]++; choice[i] < len(ambiguous.Candidates[i]) {
+				break
+			}
+			choice[i] = 0
+		}
+		if i < 0 {
+			break
+		}
+	}
+	if found == nil {
+		return false
+	}
+	copy(words, found)
+	return true
+}
+
+var (
+	prefixOnce  [Portuguese + 1]sync.Once
+	prefixLists [Portuguese + 1][]prefixWord
+)
+
+// prefixWord is a list word in the forms compared with the prefixes
+type prefixWord struct {
+	composed, folded string
+}
+
+// prefixList returns the NFC and the accent folded words of the language
+func (lan Language) prefixList() []prefixWord {
+	prefixOnce[lan].Do(func() {
+		list := lan.list()
+		words := make([]prefixWord, len(list))
+		for i, w := range list {
+			words[i] = prefixWord{composed: norm.NFC.String(w), folded: foldAccents(w)}
+		}
+		prefixLists[lan] = words
+	})
+	return prefixLists[lan]
+}
+
+// prefixMatches returns the words the prefix begins, with or without accents
+// if the language is foldable. A word equal to the prefix is the only match if
+// exact is set.
+func (lan Language) prefixMatches(prefix string, exact bool) []string {
+	prefix = norm.NFKD.String(prefix)
+	if _, ok := lan.mapping()[prefix]; ok && exact {
+		return []string{prefix}
+	}
+	// NFKD splits the Japanese dakuten and the Korean syllables
+	composed, folded := norm.NFC.String(prefix), lan.foldable()
+	if folded {
+		prefix = foldAccents(prefix)
+	}
+	var matches []string
+	for i, w := range lan.prefixList() {
+		if strings.HasPrefix(w.composed, composed) || (folded && strings.HasPrefix(w.folded, prefix)) {
+			matches = append(matches, lan.list()[i])
+		}
+	}
+	return matches
+}
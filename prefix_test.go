@@ -0,0 +1,127 @@
+package bip39
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestExpandPrefixes(t *testing.T) {
+	const english = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+	tests := []struct {
+		name  string
+		input string
+		lang  Language
+		want  string
+	}{
+		{
+			name:  "english four letters",
+			input: "CHEC FISC FIT SWOR UNLO ROUG LOTT TOOL STIN PLUC BULB RAND",
+			lang:  English,
+			want:  english,
+		},
+		{
+			name:  "spanish without accents",
+			input: "abac abac abac abac abac abac abac abac abac abac abac abie",
+			lang:  Spanish,
+			want:  "ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco abierto",
+		},
+		{
+			name:  "japanese three kana",
+			input: "ねほり ひらが とさか そつう おうじ あてな きくら みもと してつ ぱそこ にって いこつ",
+			lang:  Japanese,
+			want:  "ねほりはほり　ひらがな　とさか　そつう　おうじ　あてな　きくらげ　みもと　してつ　ぱそこん　にってい　いこつ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandPrefixes(tt.input, tt.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != norm.NFD.String(tt.want) {
+				t.Errorf("ExpandPrefixes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandPrefixesError(t *testing.T) {
+	_, err := ExpandPrefixes("che fis fit swo unl rou lot too sti plu bul ran", English)
+	var ambiguous *AmbiguousPrefixError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("ExpandPrefixes() error = %v, want an AmbiguousPrefixError", err)
+	}
+	// fit also begins fitness, swo, unl, rou and lot begin a single word
+	if want := []int{0, 1, 2, 5, 7, 8, 9, 10, 11}; !reflect.DeepEqual(ambiguous.Positions, want) {
+		t.Errorf("AmbiguousPrefixError.Positions = %v, want %v", ambiguous.Positions, want)
+	}
+	if want := []string{"bulb", "bulk", "bullet"}; !reflect.DeepEqual(ambiguous.Candidates[7], want) {
+		t.Errorf("AmbiguousPrefixError.Candidates[7] = %v, want %v", ambiguous.Candidates[7], want)
+	}
+
+	if _, err := ExpandPrefixes("chec fisc fit swor unlo roug lott tool stin pluc bulb bulb", English); err != ErrChecksumIncorrect {
+		t.Errorf("ExpandPrefixes() error = %v, want %v", err, ErrChecksumIncorrect)
+	}
+	if _, err := ExpandPrefixes("chec fisc fit swor unlo roug lott tool stin pluc bulb xyzw", English); err == nil {
+		t.Error("ExpandPrefixes() accepts an unknown prefix")
+	}
+}
+
+func TestPrefixForm(t *testing.T) {
+	got, err := PrefixForm("check fiscal fit sword unlock rough lottery tool sting pluck bulb random", English, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "chec fisc fit swor unlo roug lott tool stin pluc bulb rand"; got != want {
+		t.Errorf("PrefixForm() = %q, want %q", got, want)
+	}
+
+	for lang := ChineseSimplified; lang <= Portuguese; lang++ {
+		m, err := NewMnemonicByEntropy([]byte("0123456789abcdef0123456789abcdef"), lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		prefixes, err := PrefixForm(m, lang, 4)
+		if err != nil {
+			t.Fatalf("PrefixForm() %v error = %v", lang, err)
+		}
+		if got, err := ExpandPrefixes(prefixes, lang); err != nil || got != m {
+			t.Errorf("ExpandPrefixes() %v = %q, %v, want %q", lang, got, err, m)
+		}
+	}
+
+	var ambiguous *AmbiguousPrefixError
+	if _, err := PrefixForm("check fiscal fit sword unlock rough lottery tool sting pluck bulb random", English, 3); !errors.As(err, &ambiguous) {
+		t.Errorf("PrefixForm() error = %v, want an AmbiguousPrefixError", err)
+	}
+}
+
+func TestPrefixForm_EveryWord(t *testing.T) {
+	for _, lang := range Languages() {
+		for _, n := range []int{3, 4} {
+			for idx := range 2048 {
+				// the word is the first of the mnemonic, the others are fixed
+				entropy := []byte("\x00\x00ffffffffffffff")
+				entropy[0], entropy[1] = byte(idx>>3), byte(idx<<5)|0x13
+				m, err := NewMnemonicByEntropy(entropy, lang)
+				if err != nil {
+					t.Fatal(err)
+				}
+				prefixes, err := PrefixForm(m, lang, n)
+				var ambiguous *AmbiguousPrefixError
+				if errors.As(err, &ambiguous) {
+					continue
+				}
+				if err != nil {
+					t.Fatalf("PrefixForm(%v, %d) %q error = %v", lang, n, m, err)
+				}
+				if got, err := ExpandPrefixes(prefixes, lang); err != nil || got != m {
+					t.Fatalf("ExpandPrefixes(%v, %d) %q = %q, %v, want %q", lang, n, prefixes, got, err, m)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package recovery
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Candidates is a finite ordered space of passphrase candidates,
+// indexed access lets a search run in parallel and resume from a checkpoint
+type Candidates interface {
+	Len() uint64
+	At(i uint64) string
+}
+
+// ErrInvalidMask is returned for a malformed mask
+var ErrInvalidMask = errors.New("recovery: invalid mask")
+
+// hashcat built-in charsets
+var builtinCharsets = map[byte]string{
+	'l': "abcdefghijklmnopqrstuvwxyz",
+	'u': "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	'd': "0123456789",
+	'h': "0123456789abcdef",
+	'H': "0123456789ABCDEF",
+	's': " !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~",
+	'a': "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~",
+}
+
+// Mask is a hashcat style mask like "Satoshi?d?d?s".
+// The built-in charsets ?l ?u ?d ?h ?H ?s ?a, the custom charsets ?1 to ?4 and ?? for a literal
+// question mark are supported, the last position changes fastest.
+type Mask struct {
+	positions [][]rune
+	size      uint64
+}
+
+// NewMask parses a mask, custom charsets are referenced as ?1 to ?4 and may contain built-in charsets
+func NewMask(mask string, custom ...string) (*Mask, error) {
+	if len(custom) > 4 {
+		return nil, fmt.Errorf("%w: at most 4 custom charsets", ErrInvalidMask)
+	}
+	customSets := make([][]rune, len(custom))
+	for i, c := range custom {
+		set, err := expandCharset(c, nil)
+		if err != nil {
+			return nil, err
+		}
+		customSets[i] = set
+	}
+
+	m := &Mask{size: 1}
+	for mask != "" {
+		var set []rune
+		if mask[0] == '?' {
+			if len(mask) < 2 {
+				return nil, fmt.Errorf("%w: trailing ?", ErrInvalidMask)
+			}
+			var err error
+			if set, err = charset(mask[1], customSets); err != nil {
+				return nil, err
+			}
+			mask = mask[2:]
+		} else {
+			r, size := utf8.DecodeRuneInString(mask)
+			set, mask = []rune{r}, mask[size:]
+		}
+		if m.size > ^uint64(0)/uint64(len(set)) {
+			return nil, fmt.Errorf("%w: keyspace overflow", ErrInvalidMask)
+		}
+		m.size *= uint64(len(set))
+		m.positions = append(m.positions, set)
+	}
+	return m, nil
+}
+
+func charset(c byte, custom [][]rune) ([]rune, error) {
+	if c == '?' {
+		return []rune{'?'}, nil
+	}
+	if set, ok := builtinCharsets[c]; ok {
+		return []rune(set), nil
+	}
+	if c >= '1' && c <= '4' && int(c-'1') < len(custom) {
+		return custom[c-'1'], nil
+	}
+	return nil, fmt.Errorf("%w: unknown charset ?%c", ErrInvalidMask, c)
+}
+
+// expandCharset expands the built-in charsets referenced in a custom charset and removes duplicates
+func expandCharset(s string, custom [][]rune) ([]rune, error) {
+	var set []rune
+	seen := make(map[rune]bool)
+	add := func(rs ...rune) {
+		for _, r := range rs {
+			if !seen[r] {
+				seen[r] = true
+				set = append(set, r)
+			}
+		}
+	}
+	for s != "" {
+		if s[0] == '?' && len(s) > 1 {
+			rs, err := charset(s[1], custom)
+			if err != nil {
+				return nil, err
+			}
+			add(rs...)
+			s = s[2:]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s)
+		add(r)
+		s = s[size:]
+	}
+	if len(set) == 0 {
+		return nil, fmt.Errorf("%w: empty custom charset", ErrInvalidMask)
+	}
+	return set, nil
+}
+
+// Len returns the keyspace size of the mask
+func (m *Mask) Len() uint64 {
+	return m.size
+}
+
+// At returns the i-th candidate of the mask
+func (m *Mask) At(i uint64) string {
+	out := make([]rune, len(m.positions))
+	for p := len(m.positions) - 1; p >= 0; p-- {
+		set := m.positions[p]
+		out[p] = set[i%uint64(len(set))]
+		i /= uint64(len(set))
+	}
+	return string(out)
+}
+
+// Wordlist is a list of candidates, one per line of a wordlist file
+type Wordlist []string
+
+// ReadWordlist reads a wordlist with one candidate per line, empty lines are skipped
+func ReadWordlist(r io.Reader) (Wordlist, error) {
+	var list Wordlist
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimSuffix(scanner.Text(), "\r"); line != "" {
+			list = append(list, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Len returns the number of words
+func (w Wordlist) Len() uint64 {
+	return uint64(len(w))
+}
+
+// At returns the i-th word
+func (w Wordlist) At(i uint64) string {
+	return w[i]
+}
+
+type chain []Candidates
+
+// Chain concatenates candidate spaces
+func Chain(c ...Candidates) Candidates {
+	return chain(c)
+}
+
+func (c chain) Len() uint64 {
+	var n uint64
+	for _, part := range c {
+		n += part.Len()
+	}
+	return n
+}
+
+func (c chain) At(i uint64) string {
+	for _, part := range c {
+		if n := part.Len(); i >= n {
+			i -= n
+			continue
+		}
+		return part.At(i)
+	}
+	panic("recovery: candidate index out of range")
+}
+
+type mutations struct {
+	base      Candidates
+	mutations []func(string) string
+}
+
+// Mutate expands every base candidate with common typos: the original, lower case,
+// upper case, title case, toggled first letter case, trimmed, without spaces,
+// collapsed spaces, without the last character, with a trailing space and with
+// every character of trailing appended
+func Mutate(base Candidates, trailing string) Candidates {
+	list := []func(string) string{
+		func(s string) string { return s },
+		strings.ToLower,
+		strings.ToUpper,
+		titleCase,
+		toggleFirst,
+		strings.TrimSpace,
+		func(s string) string { return strings.ReplaceAll(s, " ", "") },
+		func(s string) string { return strings.Join(strings.Fields(s), " ") },
+		func(s string) string {
+			_, size := utf8.DecodeLastRuneInString(s)
+			return s[:len(s)-size]
+		},
+		func(s string) string { return s + " " },
+	}
+	for _, r := range trailing {
+		suffix := string(r)
+		list = append(list, func(s string) string { return s + suffix })
+	}
+	return &mutations{base: base, mutations: list}
+}
+
+func (m *mutations) Len() uint64 {
+	return m.base.Len() * uint64(len(m.mutations))
+}
+
+func (m *mutations) At(i uint64) string {
+	n := uint64(len(m.mutations))
+	return m.mutations[i%n](m.base.At(i / n))
+}
+
+func titleCase(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + strings.ToLower(s[size:])
+}
+
+func toggleFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	if unicode.IsUpper(r) {
+		return string(unicode.ToLower(r)) + s[size:]
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,104 @@
+package recovery
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func collect(c Candidates) []string {
+	var out []string
+	for i := uint64(0); i < c.Len(); i++ {
+		out = append(out, c.At(i))
+	}
+	return out
+}
+
+func TestNewMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		mask    string
+		custom  []string
+		wantLen uint64
+		wantAt  map[uint64]string
+		wantErr bool
+	}{
+		{"literal", "abc", nil, 1, map[uint64]string{0: "abc"}, false},
+		{"digits", "pin?d?d", nil, 100, map[uint64]string{0: "pin00", 42: "pin42", 99: "pin99"}, false},
+		{"question mark", "??", nil, 1, map[uint64]string{0: "?"}, false},
+		{"custom charset", "?1?l", []string{"?dX"}, 11 * 26, map[uint64]string{0: "0a", 26*10 + 25: "Xz"}, false},
+		{"unicode literal", "ü?d", nil, 10, map[uint64]string{3: "ü3"}, false},
+		{"unknown charset", "?x", nil, 0, nil, true},
+		{"undefined custom charset", "?2", []string{"ab"}, 0, nil, true},
+		{"trailing question mark", "abc?", nil, 0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMask(tt.mask, tt.custom...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMask() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidMask) {
+					t.Errorf("NewMask() error = %v, want %v", err, ErrInvalidMask)
+				}
+				return
+			}
+			if got.Len() != tt.wantLen {
+				t.Errorf("Len() = %v, want %v", got.Len(), tt.wantLen)
+			}
+			for i, want := range tt.wantAt {
+				if s := got.At(i); s != want {
+					t.Errorf("At(%d) = %v, want %v", i, s, want)
+				}
+			}
+		})
+	}
+}
+
+func TestReadWordlist(t *testing.T) {
+	got, err := ReadWordlist(strings.NewReader("hello\r\n\nworld \nsecret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Wordlist{"hello", "world ", "secret"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadWordlist() = %q, want %q", got, want)
+	}
+}
+
+func TestMutate(t *testing.T) {
+	got := collect(Mutate(Wordlist{" my Secret"}, "!1"))
+	want := []string{
+		" my Secret",
+		" my secret",
+		" MY SECRET",
+		" my secret",
+		" my Secret",
+		"my Secret",
+		"mySecret",
+		"my Secret",
+		" my Secre",
+		" my Secret ",
+		" my Secret!",
+		" my Secret1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Mutate() = %q, want %q", got, want)
+	}
+	if got := collect(Mutate(Wordlist{"abc"}, ""))[3:5]; !reflect.DeepEqual(got, []string{"Abc", "Abc"}) {
+		t.Errorf("Mutate() case = %q", got)
+	}
+}
+
+func TestChain(t *testing.T) {
+	mask, _ := NewMask("?d")
+	c := Chain(Wordlist{"a", "b"}, mask)
+	if c.Len() != 12 {
+		t.Errorf("Len() = %v, want 12", c.Len())
+	}
+	if got := collect(c)[1:4]; !reflect.DeepEqual(got, []string{"b", "0", "1"}) {
+		t.Errorf("Chain() = %q", got)
+	}
+}
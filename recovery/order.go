@@ -0,0 +1,398 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// default bound of the permutation search
+const defaultMaxPermutations = 1_000_000
+
+// the largest default sliding window
+const maxDefaultWindow = 8
+
+// Order methods
+const (
+	MethodEntered     = "entered"
+	MethodTranspose   = "transpose"
+	MethodReverse     = "reverse"
+	MethodSwap        = "swap"
+	MethodPermutation = "permutation"
+)
+
+// Error list
+var (
+	ErrInvalidPositions = errors.New("recovery: invalid positions")
+	ErrSearchTooLarge   = errors.New("recovery: too many permutations")
+	ErrUnknownWord      = errors.New("recovery: unknown word")
+)
+
+// OrderSearch configures RecoverOrder
+type OrderSearch struct {
+	// Positions are the suspected misplaced word indexes, every order of their
+	// words is tried while the other words stay in place
+	Positions []int
+
+	// Window is the length of the sliding run of consecutive words permuted when
+	// Positions is empty, defaults to the largest one up to 8 within MaxPermutations
+	Window int
+
+	// MaxPermutations bounds the permutation search, defaults to 1000000
+	MaxPermutations uint64
+
+	// Workers defaults to the number of CPUs
+	Workers int
+
+	// Target optionally filters the checksum-valid orders with a known
+	// fingerprint, extended key or address of the wallet
+	Target     *Target
+	Passphrase string
+	Paths      PathSearch
+}
+
+// OrderMatch is a checksum-valid word order
+type OrderMatch struct {
+	Mnemonic string
+	Method   string
+	// Distance is the number of word pairs in a different relative order than entered
+	Distance int
+}
+
+// permJob tries the orders of the words at positions where positions[0] takes the word at positions[first]
+type permJob struct {
+	positions []int
+	first     int
+	// skipFixedLast skips the orders keeping the last word in place, the previous window has them
+	skipFixedLast bool
+}
+
+// RecoverOrder tries grid transpositions, reversal, swaps of any two words and every order
+// of the suspected positions or, without them, of a sliding window of consecutive words.
+// The orders passing the checksum and the optional target are returned, closest to the
+// entered order first.
+func RecoverOrder(ctx context.Context, mnemonic string, lang bip39.Language, opts OrderSearch) ([]OrderMatch, error) {
+	words := strings.Fields(mnemonic)
+	n := len(words)
+	if n%3 != 0 || n < 12 || n > 24 {
+		return nil, bip39.ErrWordLen
+	}
+	// no order fixes a word missing from the list, the error names the word and its position
+	if _, err := bip39.MnemonicToEntropy(strings.Join(words, "\x20"), lang); err != nil && err != bip39.ErrChecksumIncorrect {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownWord, err)
+	}
+	if opts.MaxPermutations == 0 {
+		opts.MaxPermutations = defaultMaxPermutations
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	if opts.Target != nil {
+		opts.Paths.setDefaults(1, 20)
+		opts.Paths.Progress = nil
+	}
+	jobs, err := opts.permJobs(n)
+	if err != nil {
+		return nil, err
+	}
+	sep := lang.Info().Separator
+
+	// the fixed orders are few, a permuted order already found is skipped when merging
+	found := make(map[string]bool)
+	var matches []OrderMatch
+	try := func(method string, perm []int) error {
+		candidate, ok, err := opts.check(ctx, words, sep, lang, perm)
+		if err != nil || !ok || found[candidate] {
+			return err
+		}
+		found[candidate] = true
+		matches = append(matches, OrderMatch{Mnemonic: candidate, Method: method, Distance: inversions(perm)})
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := try(MethodEntered, identity(n)); err != nil {
+		return nil, err
+	}
+	for rows := 2; rows <= n/2; rows++ {
+		if n%rows != 0 {
+			continue
+		}
+		if err := try(MethodTranspose, transpose(rows, n/rows)); err != nil {
+			return nil, err
+		}
+	}
+	reversed := identity(n)
+	for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	if err := try(MethodReverse, reversed); err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			perm := identity(n)
+			perm[i], perm[j] = perm[j], perm[i]
+			if err := try(MethodSwap, perm); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	permuted, err := opts.searchPermutations(ctx, words, sep, lang, jobs)
+	if err != nil {
+		return nil, err
+	}
+	// the workers finish in any order
+	sort.Slice(permuted, func(i, j int) bool { return permuted[i].Mnemonic < permuted[j].Mnemonic })
+	for _, m := range permuted {
+		if !found[m.Mnemonic] {
+			found[m.Mnemonic] = true
+			matches = append(matches, m)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, ErrNotFound
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Distance < matches[j].Distance })
+	return matches, nil
+}
+
+// permJobs splits the permutation search into one job per first word of each permuted group
+func (opts *OrderSearch) permJobs(n int) ([]permJob, error) {
+	var groups [][]int
+	if len(opts.Positions) > 0 {
+		seen := make(map[int]bool)
+		for _, p := range opts.Positions {
+			if p < 0 || p >= n || seen[p] {
+				return nil, ErrInvalidPositions
+			}
+			seen[p] = true
+		}
+		if len(opts.Positions) < 2 {
+			return nil, ErrInvalidPositions
+		}
+		if !factorialAtMost(len(opts.Positions), opts.MaxPermutations) {
+			return nil, ErrSearchTooLarge
+		}
+		positions := slices.Clone(opts.Positions)
+		slices.Sort(positions)
+		groups = append(groups, positions)
+	} else {
+		window := opts.Window
+		if window == 0 {
+			for window = maxDefaultWindow; window > 2 && !windowsAtMost(n, window, opts.MaxPermutations); window-- {
+			}
+		}
+		if window < 2 || window > n {
+			return nil, ErrInvalidPositions
+		}
+		if !windowsAtMost(n, window, opts.MaxPermutations) {
+			return nil, ErrSearchTooLarge
+		}
+		for start := 0; start+window <= n; start++ {
+			positions := make([]int, window)
+			for i := range positions {
+				positions[i] = start + i
+			}
+			groups = append(groups, positions)
+		}
+	}
+
+	var jobs []permJob
+	for g, positions := range groups {
+		for first := range positions {
+			jobs = append(jobs, permJob{positions: positions, first: first, skipFixedLast: g > 0})
+		}
+	}
+	return jobs, nil
+}
+
+// searchPermutations runs the jobs in parallel, the candidates are only kept if they match
+func (opts *OrderSearch) searchPermutations(ctx context.Context, words []string, sep string, lang bip39.Language, jobs []permJob) ([]OrderMatch, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type jobResult struct {
+		matches []OrderMatch
+		err     error
+	}
+	jobc := make(chan permJob)
+	results := make(chan jobResult)
+
+	go func() {
+		defer close(jobc)
+		for _, job := range jobs {
+			select {
+			case jobc <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobc {
+				var res jobResult
+				res.matches, res.err = opts.runJob(ctx, words, sep, lang, job)
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var matches []OrderMatch
+	for res := range results {
+		if res.err != nil {
+			return nil, res.err
+		}
+		matches = append(matches, res.matches...)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+func (opts *OrderSearch) runJob(ctx context.Context, words []string, sep string, lang bip39.Language, job permJob) ([]OrderMatch, error) {
+	k := len(job.positions)
+	order := slices.Clone(job.positions)
+	order[0], order[job.first] = order[job.first], order[0]
+	perm := identity(len(words))
+
+	var matches []OrderMatch
+	var checked int
+	err := permute(order, 1, func(order []int) error {
+		if job.skipFixedLast && order[k-1] == job.positions[k-1] {
+			return nil
+		}
+		if checked++; checked%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		for i, p := range job.positions {
+			perm[p] = order[i]
+		}
+		candidate, ok, err := opts.check(ctx, words, sep, lang, perm)
+		if err != nil || !ok {
+			return err
+		}
+		matches = append(matches, OrderMatch{Mnemonic: candidate, Method: MethodPermutation, Distance: inversions(perm)})
+		return nil
+	})
+	return matches, err
+}
+
+// check returns the words in perm order and whether it passes the checksum and the target
+func (opts *OrderSearch) check(ctx context.Context, words []string, sep string, lang bip39.Language, perm []int) (string, bool, error) {
+	ordered := make([]string, len(perm))
+	for i, p := range perm {
+		ordered[i] = words[p]
+	}
+	candidate := strings.Join(ordered, sep)
+	if bip39.CheckMnemonic(candidate, lang) != nil {
+		return candidate, false, nil
+	}
+	if opts.Target != nil {
+		ok, err := opts.matchTarget(ctx, candidate)
+		return candidate, ok, err
+	}
+	return candidate, true, nil
+}
+
+func (opts *OrderSearch) matchTarget(ctx context.Context, mnemonic string) (bool, error) {
+	master, err := hdkey.NewMaster(bip39.MnemonicToSeed(mnemonic, opts.Passphrase))
+	if err != nil {
+		return false, err
+	}
+	match, err := opts.Paths.searchMaster(ctx, master, *opts.Target, &Progress{})
+	return match != nil, err
+}
+
+func identity(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// transpose returns the order of words written row by row in a rows x cols grid
+// but entered column by column
+func transpose(rows, cols int) []int {
+	perm := make([]int, 0, rows*cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			perm = append(perm, c*rows+r)
+		}
+	}
+	return perm
+}
+
+// inversions returns the Kendall tau distance from the identity order
+func inversions(perm []int) int {
+	var count int
+	for i := range perm {
+		for j := i + 1; j < len(perm); j++ {
+			if perm[i] > perm[j] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// windowsAtMost reports whether the sliding windows of size k over n words have at most bound orders
+func windowsAtMost(n, k int, bound uint64) bool {
+	return factorialAtMost(k, bound/uint64(n-k+1))
+}
+
+func factorialAtMost(n int, bound uint64) bool {
+	f := uint64(1)
+	for i := 2; i <= n; i++ {
+		if f > bound/uint64(i) {
+			return false
+		}
+		f *= uint64(i)
+	}
+	return f <= bound
+}
+
+// permute calls fn with every permutation of perm[k:]
+func permute(perm []int, k int, fn func([]int) error) error {
+	if k == len(perm) {
+		return fn(perm)
+	}
+	for i := k; i < len(perm); i++ {
+		perm[k], perm[i] = perm[i], perm[k]
+		err := permute(perm, k+1, fn)
+		perm[k], perm[i] = perm[i], perm[k]
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
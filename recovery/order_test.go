@@ -0,0 +1,171 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/islishude/bip39"
+)
+
+const checkMnemonic = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+
+func reorder(mnemonic string, perm []int) string {
+	words := strings.Fields(mnemonic)
+	out := make([]string, len(words))
+	for i, p := range perm {
+		out[p] = words[i]
+	}
+	return strings.Join(out, " ")
+}
+
+func TestRecoverOrder(t *testing.T) {
+	swapped := identity(12)
+	swapped[4], swapped[5] = swapped[5], swapped[4]
+
+	tests := []struct {
+		name       string
+		entered    string
+		wantMethod string
+	}{
+		{"read column-wise from 3x4 grid", reorder(checkMnemonic, transpose(3, 4)), MethodTranspose},
+		{"read column-wise from 4x3 grid", reorder(checkMnemonic, transpose(4, 3)), MethodTranspose},
+		{"adjacent swap", reorder(checkMnemonic, swapped), MethodSwap},
+		{"entered correctly", checkMnemonic, MethodEntered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverOrder(context.Background(), tt.entered, bip39.English, OrderSearch{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found bool
+			for i, m := range got {
+				if i > 0 && got[i-1].Distance > m.Distance {
+					t.Errorf("RecoverOrder() is not ranked: %+v", got)
+				}
+				if m.Mnemonic == checkMnemonic {
+					found = true
+					if m.Method != tt.wantMethod {
+						t.Errorf("RecoverOrder() method = %v, want %v", m.Method, tt.wantMethod)
+					}
+				}
+			}
+			if !found {
+				t.Errorf("RecoverOrder() = %+v, want %v", got, checkMnemonic)
+			}
+		})
+	}
+}
+
+func TestRecoverOrder_Permutation(t *testing.T) {
+	// a rotation of three words is neither a swap nor a grid transposition
+	window := identity(12)
+	window[5], window[6], window[7] = window[6], window[7], window[5]
+	spread := identity(12)
+	spread[1], spread[6], spread[10] = spread[6], spread[10], spread[1]
+
+	tests := []struct {
+		name    string
+		entered string
+		opts    OrderSearch
+	}{
+		{"sliding window", reorder(checkMnemonic, window), OrderSearch{Window: 4}},
+		{"default window", reorder(checkMnemonic, window), OrderSearch{}},
+		{"suspected positions", reorder(checkMnemonic, spread), OrderSearch{Positions: []int{10, 1, 6}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverOrder(context.Background(), tt.entered, bip39.English, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := make(map[string]bool)
+			for _, m := range got {
+				if found[m.Mnemonic] {
+					t.Errorf("RecoverOrder() has duplicate %v", m.Mnemonic)
+				}
+				found[m.Mnemonic] = true
+				if m.Mnemonic == checkMnemonic && m.Method != MethodPermutation {
+					t.Errorf("RecoverOrder() method = %v, want %v", m.Method, MethodPermutation)
+				}
+			}
+			if !found[checkMnemonic] {
+				t.Errorf("RecoverOrder() = %+v, want %v", got, checkMnemonic)
+			}
+		})
+	}
+}
+
+func TestRecoverOrder_Target(t *testing.T) {
+	target := fingerprintTarget(t, checkMnemonic, "")
+	entered := reorder(checkMnemonic, transpose(4, 3))
+
+	got, err := RecoverOrder(context.Background(), entered, bip39.English, OrderSearch{Target: &target, Window: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Mnemonic != checkMnemonic {
+		t.Errorf("RecoverOrder() = %+v, want only %v", got, checkMnemonic)
+	}
+}
+
+func TestRecoverOrder_Errors(t *testing.T) {
+	if _, err := RecoverOrder(context.Background(), "check fiscal", bip39.English, OrderSearch{}); err != bip39.ErrWordLen {
+		t.Errorf("RecoverOrder() error = %v, want %v", err, bip39.ErrWordLen)
+	}
+	unknown := strings.Replace(checkMnemonic, "lottery", "lotery", 1)
+	_, err := RecoverOrder(context.Background(), unknown, bip39.English, OrderSearch{})
+	if !errors.Is(err, ErrUnknownWord) || !strings.Contains(err.Error(), "`lotery` at `6`") {
+		t.Errorf("RecoverOrder() error = %v, want %v", err, ErrUnknownWord)
+	}
+	target := fingerprintTarget(t, abandonMnemonic, "")
+	if _, err := RecoverOrder(context.Background(), checkMnemonic, bip39.English, OrderSearch{Target: &target, Window: 2}); err != ErrNotFound {
+		t.Errorf("RecoverOrder() error = %v, want %v", err, ErrNotFound)
+	}
+
+	searches := []struct {
+		name string
+		opts OrderSearch
+		want error
+	}{
+		{"position out of range", OrderSearch{Positions: []int{0, 12}}, ErrInvalidPositions},
+		{"duplicate position", OrderSearch{Positions: []int{3, 3}}, ErrInvalidPositions},
+		{"single position", OrderSearch{Positions: []int{3}}, ErrInvalidPositions},
+		{"window too long", OrderSearch{Window: 13}, ErrInvalidPositions},
+		{"positions over the bound", OrderSearch{Positions: []int{0, 1, 2, 3, 4}, MaxPermutations: 100}, ErrSearchTooLarge},
+		{"window over the bound", OrderSearch{Window: 12}, ErrSearchTooLarge},
+	}
+	for _, tt := range searches {
+		if _, err := RecoverOrder(context.Background(), checkMnemonic, bip39.English, tt.opts); err != tt.want {
+			t.Errorf("RecoverOrder() %s error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := RecoverOrder(ctx, checkMnemonic, bip39.English, OrderSearch{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("RecoverOrder() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func Test_permute(t *testing.T) {
+	seen := make(map[[4]int]bool)
+	_ = permute(identity(4), 0, func(p []int) error {
+		seen[[4]int(p)] = true
+		return nil
+	})
+	if len(seen) != 24 {
+		t.Errorf("permute() produced %d permutations, want 24", len(seen))
+	}
+	if !windowsAtMost(12, 8, 1_000_000) || windowsAtMost(12, 9, 1_000_000) {
+		t.Error("windowsAtMost() is wrong")
+	}
+	if !factorialAtMost(4, 24) || factorialAtMost(4, 23) || factorialAtMost(21, 1<<62) {
+		t.Error("factorialAtMost() is wrong")
+	}
+	if got := inversions([]int{2, 1, 0}); got != 3 {
+		t.Errorf("inversions() = %v, want 3", got)
+	}
+}
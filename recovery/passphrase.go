@@ -0,0 +1,149 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// ErrInvalidMnemonic is returned when the mnemonic is not valid in any language
+var ErrInvalidMnemonic = errors.New("recovery: invalid mnemonic")
+
+// candidates of one job
+const passphraseBatch = 16
+
+// PassphraseSearch configures SearchPassphrase
+type PassphraseSearch struct {
+	// Workers defaults to the number of CPUs
+	Workers int
+
+	// Start is the candidate index to start from, pass Progress.Checked of
+	// an interrupted search to resume it
+	Start uint64
+
+	// Paths is the search space for extended key and address targets, the zero
+	// value checks the first account and 5 addresses of both branches for every script type
+	Paths PathSearch
+
+	// Progress is called after every batch of candidates, Checked is the number of
+	// leading candidates which are all checked and is a resumable checkpoint
+	Progress func(Progress)
+}
+
+type batchResult struct {
+	start uint64
+	match *PathMatch
+	err   error
+}
+
+// SearchPassphrase tests the candidates as the BIP39 passphrase of the mnemonic in parallel
+// until the seed matches the target.
+// If the context is canceled the last reported Progress.Checked can be used to resume.
+func SearchPassphrase(ctx context.Context, mnemonic string, target Target, candidates Candidates, opts PassphraseSearch) (*PathMatch, error) {
+	if !validMnemonic(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	opts.Paths.setDefaults(1, 5)
+	opts.Paths.Progress = nil
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	total := candidates.Len()
+	jobs := make(chan uint64)
+	results := make(chan batchResult)
+
+	go func() {
+		defer close(jobs)
+		for start := opts.Start; start < total; start += passphraseBatch {
+			select {
+			case jobs <- start:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range jobs {
+				res := batchResult{start: start}
+				res.match, res.err = opts.checkBatch(ctx, mnemonic, target, candidates, start, min(start+passphraseBatch, total))
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// checkpoint only advances over contiguous finished batches
+	checkpoint := opts.Start
+	finished := make(map[uint64]bool)
+	for res := range results {
+		if res.err != nil {
+			return nil, res.err
+		}
+		if res.match != nil {
+			return res.match, nil
+		}
+		finished[res.start] = true
+		for finished[checkpoint] {
+			delete(finished, checkpoint)
+			checkpoint = min(checkpoint+passphraseBatch, total)
+		}
+		if opts.Progress != nil {
+			opts.Progress(Progress{Checked: checkpoint, Total: total})
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, ErrNotFound
+}
+
+func (opts *PassphraseSearch) checkBatch(ctx context.Context, mnemonic string, target Target, candidates Candidates, start, end uint64) (*PathMatch, error) {
+	for i := start; i < end; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		passphrase := candidates.At(i)
+		master, err := hdkey.NewMaster(bip39.MnemonicToSeed(mnemonic, passphrase))
+		if err != nil {
+			return nil, err
+		}
+		match, err := opts.Paths.searchMaster(ctx, master, target, &Progress{})
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			match.Passphrase = passphrase
+			return match, nil
+		}
+	}
+	return nil, nil
+}
+
+func validMnemonic(mnemonic string) bool {
+	for _, lang := range bip39.Languages() {
+		if bip39.IsMnemonicValid(mnemonic, lang) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package recovery
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+func fingerprintTarget(t *testing.T, mnemonic, passphrase string) Target {
+	master, err := hdkey.NewMaster(bip39.MnemonicToSeed(mnemonic, passphrase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp := master.Fingerprint()
+	target, err := ParseTarget(hex.EncodeToString(fp[:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return target
+}
+
+func TestSearchPassphrase(t *testing.T) {
+	mask, _ := NewMask("TREZO?u")
+	tests := []struct {
+		name       string
+		target     func() Target
+		candidates Candidates
+		opts       PassphraseSearch
+		want       string
+		wantErr    error
+	}{
+		{
+			name:       "mask",
+			target:     func() Target { return fingerprintTarget(t, abandonMnemonic, "TREZOR") },
+			candidates: mask,
+			want:       "TREZOR",
+		},
+		{
+			name:       "typo mutation",
+			target:     func() Target { return fingerprintTarget(t, abandonMnemonic, "Trezor!") },
+			candidates: Mutate(Mutate(Wordlist{"bitcoin", "trezor"}, ""), "!"),
+			opts:       PassphraseSearch{Workers: 2},
+			want:       "Trezor!",
+		},
+		{
+			name: "address target",
+			target: func() Target {
+				target, _ := ParseTarget("bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu")
+				return target
+			},
+			candidates: Wordlist{"foo", "bar", ""},
+			want:       "",
+		},
+		{
+			name:       "resume after the match",
+			target:     func() Target { return fingerprintTarget(t, abandonMnemonic, "TREZOR") },
+			candidates: mask,
+			opts:       PassphraseSearch{Start: 18},
+			wantErr:    ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SearchPassphrase(context.Background(), abandonMnemonic, tt.target(), tt.candidates, tt.opts)
+			if err != tt.wantErr {
+				t.Errorf("SearchPassphrase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.Passphrase != tt.want {
+				t.Errorf("SearchPassphrase() = %q, want %q", got.Passphrase, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPassphrase_Progress(t *testing.T) {
+	mask, _ := NewMask("?d?d")
+	target := fingerprintTarget(t, abandonMnemonic, "not a candidate")
+
+	var last Progress
+	opts := PassphraseSearch{Start: 64, Progress: func(p Progress) { last = p }}
+	if _, err := SearchPassphrase(context.Background(), abandonMnemonic, target, mask, opts); err != ErrNotFound {
+		t.Fatalf("SearchPassphrase() error = %v, want %v", err, ErrNotFound)
+	}
+	if want := (Progress{Checked: 100, Total: 100}); last != want {
+		t.Errorf("Progress = %+v, want %+v", last, want)
+	}
+}
+
+func TestSearchPassphrase_Errors(t *testing.T) {
+	mask, _ := NewMask("?d")
+	target := fingerprintTarget(t, abandonMnemonic, "")
+
+	if _, err := SearchPassphrase(context.Background(), "abandon about", target, mask, PassphraseSearch{}); err != ErrInvalidMnemonic {
+		t.Errorf("SearchPassphrase() error = %v, want %v", err, ErrInvalidMnemonic)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := SearchPassphrase(ctx, abandonMnemonic, target, mask, PassphraseSearch{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("SearchPassphrase() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,185 @@
+package recovery
+
+import (
+	"context"
+
+	"github.com/islishude/bip39"
+	"github.com/islishude/bip39/descriptor"
+	"github.com/islishude/bip39/internal/address"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// default path search space
+const (
+	defaultAccounts = 5
+	defaultGapLimit = 20
+)
+
+// PathSearch configures SearchPath, the zero value searches the first 5 accounts
+// and 20 addresses of both branches for every script type without passphrase
+type PathSearch struct {
+	Scripts     []descriptor.ScriptType
+	Network     descriptor.Network
+	Accounts    uint32
+	GapLimit    uint32
+	Passphrases []string
+
+	// Progress is called after every branch is searched
+	Progress func(Progress)
+}
+
+// Progress reports how many candidates of the total have been checked
+type Progress struct {
+	Checked uint64
+	Total   uint64
+}
+
+// PathMatch is the result of a search
+type PathMatch struct {
+	Passphrase string
+	Script     descriptor.ScriptType
+	Path       string
+	Address    string
+}
+
+// SearchPath derives the accounts and addresses of the mnemonic until one matches the target.
+// A fingerprint target matches the master key and only selects the passphrase,
+// an extended key target matches the account key, an address target matches a receive or change address.
+func SearchPath(ctx context.Context, mnemonic string, target Target, opts PathSearch) (*PathMatch, error) {
+	opts.setDefaults(defaultAccounts, defaultGapLimit)
+	if len(opts.Passphrases) == 0 {
+		opts.Passphrases = []string{""}
+	}
+
+	progress := Progress{Total: uint64(len(opts.Passphrases)) * opts.candidates(target)}
+	for _, passphrase := range opts.Passphrases {
+		master, err := hdkey.NewMaster(bip39.MnemonicToSeed(mnemonic, passphrase))
+		if err != nil {
+			return nil, err
+		}
+		match, err := opts.searchMaster(ctx, master, target, &progress)
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			match.Passphrase = passphrase
+			return match, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (opts *PathSearch) setDefaults(accounts, gapLimit uint32) {
+	if len(opts.Scripts) == 0 {
+		opts.Scripts = []descriptor.ScriptType{descriptor.PKH, descriptor.ShWPKH, descriptor.WPKH, descriptor.TR}
+	}
+	if opts.Accounts == 0 {
+		opts.Accounts = accounts
+	}
+	if opts.GapLimit == 0 {
+		opts.GapLimit = gapLimit
+	}
+}
+
+// candidates returns how many keys of a master key are compared with the target
+func (opts *PathSearch) candidates(target Target) uint64 {
+	switch target.Kind {
+	case ExtendedKey:
+		return uint64(len(opts.Scripts)) * uint64(opts.Accounts)
+	case Address:
+		return uint64(len(opts.Scripts)) * uint64(opts.Accounts) * 2 * uint64(opts.GapLimit)
+	}
+	return 1
+}
+
+// searchMaster compares the keys of a master key with the target, it returns nil without error if nothing matches
+func (opts *PathSearch) searchMaster(ctx context.Context, master *hdkey.Key, target Target, progress *Progress) (*PathMatch, error) {
+	if target.Kind == Fingerprint {
+		progress.Checked++
+		opts.report(*progress)
+		if target.matchFingerprint(master) {
+			return &PathMatch{Path: "m"}, nil
+		}
+		return nil, nil
+	}
+
+	coin, params := uint32(0), address.MainNet
+	if opts.Network == descriptor.Testnet {
+		coin, params = 1, address.TestNet
+	}
+	for _, script := range opts.Scripts {
+		purpose, err := script.Purpose()
+		if err != nil {
+			return nil, err
+		}
+		addrType := addressType(script)
+		for account := uint32(0); account < opts.Accounts; account++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			path := []uint32{purpose + hdkey.Hardened, coin + hdkey.Hardened, account + hdkey.Hardened}
+			accountKey, err := master.Derive(path)
+			if err != nil {
+				return nil, err
+			}
+			accountKey = accountKey.Neuter()
+
+			if target.Kind == ExtendedKey {
+				progress.Checked++
+				opts.report(*progress)
+				if target.matchKey(accountKey) {
+					return &PathMatch{Script: script, Path: hdkey.FormatPath(path)}, nil
+				}
+				continue
+			}
+
+			for change := uint32(0); change < 2; change++ {
+				branch, err := accountKey.Child(change)
+				if err != nil {
+					return nil, err
+				}
+				for index := uint32(0); index < opts.GapLimit; index++ {
+					child, err := branch.Child(index)
+					if err != nil {
+						// invalid child keys are skipped by wallets
+						continue
+					}
+					addr, err := address.Encode(addrType, child.PublicKey(), params)
+					if err != nil {
+						return nil, err
+					}
+					if target.matchAddress(addr) {
+						return &PathMatch{
+							Script:  script,
+							Path:    hdkey.FormatPath(append(path, change, index)),
+							Address: addr,
+						}, nil
+					}
+				}
+				progress.Checked += uint64(opts.GapLimit)
+				opts.report(*progress)
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (opts *PathSearch) report(p Progress) {
+	if opts.Progress != nil {
+		opts.Progress(p)
+	}
+}
+
+func addressType(script descriptor.ScriptType) address.Type {
+	switch script {
+	case descriptor.ShWPKH:
+		return address.P2SHP2WPKH
+	case descriptor.WPKH:
+		return address.P2WPKH
+	case descriptor.TR:
+		return address.P2TR
+	default:
+		return address.P2PKH
+	}
+}
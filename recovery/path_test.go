@@ -0,0 +1,99 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/islishude/bip39/descriptor"
+)
+
+const abandonMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestSearchPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		opts    PathSearch
+		want    PathMatch
+		wantErr error
+	}{
+		{
+			name:   "BIP84 change address",
+			target: "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el",
+			want: PathMatch{
+				Script:  descriptor.WPKH,
+				Path:    "m/84'/0'/0'/1/0",
+				Address: "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el",
+			},
+		},
+		{
+			name:   "BIP86 address",
+			target: "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+			want: PathMatch{
+				Script:  descriptor.TR,
+				Path:    "m/86'/0'/0'/0/0",
+				Address: "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+			},
+		},
+		{
+			name:   "zpub of BIP84 account",
+			target: "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs",
+			want:   PathMatch{Script: descriptor.WPKH, Path: "m/84'/0'/0'"},
+		},
+		{
+			name:   "fingerprint selects passphrase",
+			target: "73c5da0a",
+			opts:   PathSearch{Passphrases: []string{"TREZOR", ""}},
+			want:   PathMatch{Path: "m"},
+		},
+		{
+			name:    "out of gap limit",
+			target:  "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el",
+			opts:    PathSearch{Scripts: []descriptor.ScriptType{descriptor.PKH}, Accounts: 1, GapLimit: 1},
+			wantErr: ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := ParseTarget(tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := SearchPath(context.Background(), abandonMnemonic, target, tt.opts)
+			if err != tt.wantErr {
+				t.Errorf("SearchPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("SearchPath() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPath_Cancel(t *testing.T) {
+	target, _ := ParseTarget("1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := SearchPath(ctx, abandonMnemonic, target, PathSearch{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("SearchPath() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSearchPath_Progress(t *testing.T) {
+	target, _ := ParseTarget("1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA")
+	var last Progress
+	opts := PathSearch{
+		Scripts:  []descriptor.ScriptType{descriptor.WPKH},
+		Accounts: 2,
+		GapLimit: 3,
+		Progress: func(p Progress) { last = p },
+	}
+	if _, err := SearchPath(context.Background(), abandonMnemonic, target, opts); err != ErrNotFound {
+		t.Fatalf("SearchPath() error = %v, want %v", err, ErrNotFound)
+	}
+	if want := (Progress{Checked: 12, Total: 12}); last != want {
+		t.Errorf("Progress = %+v, want %+v", last, want)
+	}
+}
@@ -0,0 +1,77 @@
+// Package recovery searches for lost wallet details of a BIP39 mnemonic offline.
+//
+// Every search is driven by a Target, which is a known master fingerprint,
+// extended public key or address of the wallet to recover.
+package recovery
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/islishude/bip39/internal/base58"
+	"github.com/islishude/bip39/internal/bech32"
+	"github.com/islishude/bip39/internal/hdkey"
+)
+
+// Error list
+var (
+	ErrInvalidTarget = errors.New("recovery: invalid target")
+	ErrNotFound      = errors.New("recovery: no match found")
+)
+
+// TargetKind is the kind of a target
+type TargetKind int
+
+// TargetKind list
+const (
+	Fingerprint TargetKind = iota
+	ExtendedKey
+	Address
+)
+
+// Target is the known wallet detail a search looks for
+type Target struct {
+	Kind TargetKind
+
+	fingerprint [4]byte
+	key         *hdkey.Key
+	address     string
+}
+
+// ParseTarget parses a 8 hex chars master fingerprint, an extended public key
+// of any version (xpub, ypub, zpub...) or a base58 or bech32 address
+func ParseTarget(s string) (Target, error) {
+	s = strings.TrimSpace(s)
+	if b, err := hex.DecodeString(s); err == nil && len(b) == 4 {
+		t := Target{Kind: Fingerprint}
+		copy(t.fingerprint[:], b)
+		return t, nil
+	}
+	if key, err := hdkey.Parse(s); err == nil {
+		return Target{Kind: ExtendedKey, key: key.Neuter()}, nil
+	}
+	if _, err := base58.CheckDecode(s); err == nil {
+		return Target{Kind: Address, address: s}, nil
+	}
+	if _, _, _, err := bech32.Decode(s); err == nil {
+		return Target{Kind: Address, address: strings.ToLower(s)}, nil
+	}
+	return Target{}, ErrInvalidTarget
+}
+
+func (t Target) matchFingerprint(master *hdkey.Key) bool {
+	return t.Kind == Fingerprint && master.Fingerprint() == t.fingerprint
+}
+
+// matchKey reports whether the key equals the target regardless of the version
+func (t Target) matchKey(key *hdkey.Key) bool {
+	return t.Kind == ExtendedKey &&
+		key.ChainCode == t.key.ChainCode &&
+		bytes.Equal(key.PublicKey(), t.key.PublicKey())
+}
+
+func (t Target) matchAddress(addr string) bool {
+	return t.Kind == Address && addr == t.address
+}
@@ -0,0 +1,31 @@
+package recovery
+
+import "testing"
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    TargetKind
+		wantErr error
+	}{
+		{"fingerprint", "73c5da0a", Fingerprint, nil},
+		{"xpub", "xpub6CatWdiZiodmUeTDp8LT5or8nmbKNcuyvz7WyksVFkKB4RHwCD3XyuvPEbvqAQY3rAPshWcMLoP2fMFMKHPJ4ZeZXYVUhLv1VMrjPC7PW6V", ExtendedKey, nil},
+		{"zpub", "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs", ExtendedKey, nil},
+		{"base58 address", "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", Address, nil},
+		{"bech32 address", "BC1QCR8TE4KR609GCAWUTMRZA0J4XV80JY8Z306FYU", Address, nil},
+		{"invalid", "hello", 0, ErrInvalidTarget},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTarget(tt.input)
+			if err != tt.wantErr {
+				t.Errorf("ParseTarget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.Kind != tt.want {
+				t.Errorf("ParseTarget() kind = %v, want %v", got.Kind, tt.want)
+			}
+		})
+	}
+}
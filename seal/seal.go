@@ -0,0 +1,333 @@
+// Package seal stores mnemonics encrypted at rest in a versioned,
+// self-describing container.
+//
+// The container holds the mnemonic language and entropy encrypted with AES-256-GCM
+// or XChaCha20-Poly1305 under a key derived from a password by scrypt or
+// Argon2id. The whole header, including a free form label, is authenticated
+// as associated data, so a container can't be relabeled or downgraded
+// without the password. Containers are binary or PEM armored text.
+//
+// Binary layout, integers are big endian:
+//
+//	magic "B39S" | version | kdf | cipher | 3 x uint32 kdf params |
+//	salt length | salt | nonce | uint16 label length | label | ciphertext
+//
+// The plaintext is the length prefixed BCP 47 tag of the language, such as
+// "en" or "zh-Hans", followed by the entropy.
+package seal
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/islishude/bip39"
+)
+
+// Version is the container version written by Seal
+const Version = 1
+
+const (
+	magic     = "B39S"
+	saltSize  = 16
+	pemType   = "BIP39 ENCRYPTED MNEMONIC"
+	keySize   = 32
+	maxLabel  = 1<<16 - 1
+	paramSize = 12
+)
+
+// limits of the kdf params accepted by Open
+const (
+	maxScryptMemory = 1 << 30 // 128·N·r bytes
+	maxScryptWork   = 4 << 30 // 128·N·r·p bytes hashed
+	maxScryptR      = 32
+	maxScryptP      = 16
+	maxArgonTime    = 32
+	maxArgonMemory  = 1 << 20 // 1GiB in KiB
+	maxArgonWork    = 4 << 20 // time·memory KiB hashed
+)
+
+// Error list
+var (
+	ErrFormat      = errors.New("seal: malformed container")
+	ErrVersion     = errors.New("seal: unsupported container version")
+	ErrUnsupported = errors.New("seal: unsupported kdf, cipher or parameters")
+	ErrDecrypt     = errors.New("seal: wrong password or corrupted container")
+	ErrLabel       = errors.New("seal: label too long")
+)
+
+// KDF is the password based key derivation function
+type KDF byte
+
+// KDF list
+const (
+	Argon2id KDF = iota + 1
+	Scrypt
+)
+
+// Cipher is the authenticated encryption algorithm
+type Cipher byte
+
+// Cipher list
+const (
+	XChaCha20Poly1305 Cipher = iota + 1
+	AES256GCM
+)
+
+// Options are the Seal options, the zero value uses Argon2id and
+// XChaCha20-Poly1305 with the recommended costs
+type Options struct {
+	KDF    KDF
+	Cipher Cipher
+	Label  string
+
+	// scrypt costs, 1<<17, 8 and 1 by default
+	ScryptN, ScryptR, ScryptP uint32
+	// Argon2id costs, 3 passes, 64MiB and 4 threads by default
+	ArgonTime, ArgonMemory uint32
+	ArgonThreads           uint8
+
+	// Rand is the salt and nonce source, crypto/rand by default
+	Rand io.Reader
+}
+
+// Header is the plain text part of a container
+type Header struct {
+	Version byte
+	KDF     KDF
+	Cipher  Cipher
+	Params  [3]uint32 // N, r, p for scrypt or time, memory KiB, threads for Argon2id
+	Salt    []byte
+	Nonce   []byte
+	Label   string
+}
+
+func (o *Options) header() (*Header, error) {
+	h := &Header{Version: Version, KDF: o.KDF, Cipher: o.Cipher, Label: o.Label}
+	if h.KDF == 0 {
+		h.KDF = Argon2id
+	}
+	if h.Cipher == 0 {
+		h.Cipher = XChaCha20Poly1305
+	}
+	switch h.KDF {
+	case Scrypt:
+		h.Params = [3]uint32{orDefault(o.ScryptN, 1<<17), orDefault(o.ScryptR, 8), orDefault(o.ScryptP, 1)}
+	case Argon2id:
+		h.Params = [3]uint32{orDefault(o.ArgonTime, 3), orDefault(o.ArgonMemory, 64<<10), orDefault(uint32(o.ArgonThreads), 4)}
+	default:
+		return nil, ErrUnsupported
+	}
+	if len(h.Label) > maxLabel {
+		return nil, ErrLabel
+	}
+
+	random := o.Rand
+	if random == nil {
+		random = rand.Reader
+	}
+	h.Salt = make([]byte, saltSize)
+	h.Nonce = make([]byte, h.Cipher.nonceSize())
+	if len(h.Nonce) == 0 {
+		return nil, ErrUnsupported
+	}
+	if _, err := io.ReadFull(random, h.Salt); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(random, h.Nonce); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func orDefault(v, def uint32) uint32 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+func (c Cipher) nonceSize() int {
+	switch c {
+	case XChaCha20Poly1305:
+		return chacha20poly1305.NonceSizeX
+	case AES256GCM:
+		return 12
+	}
+	return 0
+}
+
+func (h *Header) marshal() []byte {
+	b := append([]byte(magic), h.Version, byte(h.KDF), byte(h.Cipher))
+	for _, p := range h.Params {
+		b = binary.BigEndian.AppendUint32(b, p)
+	}
+	b = append(b, byte(len(h.Salt)))
+	b = append(b, h.Salt...)
+	b = append(b, h.Nonce...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(h.Label)))
+	return append(b, h.Label...)
+}
+
+// ParseHeader returns the header of a binary container and its length, no
+// password is needed to read the label and the algorithms
+func ParseHeader(data []byte) (*Header, int, error) {
+	r := bytes.NewReader(data)
+	fixed := make([]byte, len(magic)+3+paramSize+1)
+	if _, err := io.ReadFull(r, fixed); err != nil || string(fixed[:len(magic)]) != magic {
+		return nil, 0, ErrFormat
+	}
+	h := &Header{Version: fixed[4], KDF: KDF(fixed[5]), Cipher: Cipher(fixed[6])}
+	if h.Version != Version {
+		return nil, 0, ErrVersion
+	}
+	for i := range h.Params {
+		h.Params[i] = binary.BigEndian.Uint32(fixed[7+i*4:])
+	}
+	h.Salt = make([]byte, fixed[len(fixed)-1])
+	h.Nonce = make([]byte, h.Cipher.nonceSize())
+	if len(h.Nonce) == 0 {
+		return nil, 0, ErrUnsupported
+	}
+	var size [2]byte
+	if _, err := io.ReadFull(r, h.Salt); err != nil {
+		return nil, 0, ErrFormat
+	}
+	if _, err := io.ReadFull(r, h.Nonce); err != nil {
+		return nil, 0, ErrFormat
+	}
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, 0, ErrFormat
+	}
+	label := make([]byte, binary.BigEndian.Uint16(size[:]))
+	if _, err := io.ReadFull(r, label); err != nil {
+		return nil, 0, ErrFormat
+	}
+	h.Label = string(label)
+	return h, len(data) - r.Len(), nil
+}
+
+func (h *Header) key(password []byte) ([]byte, error) {
+	n, r, p := h.Params[0], h.Params[1], h.Params[2]
+	switch h.KDF {
+	case Scrypt:
+		// N is a power of two above 1
+		if n < 2 || n&(n-1) != 0 || r == 0 || r > maxScryptR || p == 0 || p > maxScryptP {
+			return nil, ErrUnsupported
+		}
+		if memory := 128 * uint64(n) * uint64(r); memory > maxScryptMemory || memory*uint64(p) > maxScryptWork {
+			return nil, ErrUnsupported
+		}
+		return scrypt.Key(password, h.Salt, int(n), int(r), int(p), keySize)
+	case Argon2id:
+		if n == 0 || n > maxArgonTime || r > maxArgonMemory || uint64(n)*uint64(r) > maxArgonWork || p == 0 || p > 255 {
+			return nil, ErrUnsupported
+		}
+		return argon2.IDKey(password, h.Salt, n, r, uint8(p), keySize), nil
+	}
+	return nil, ErrUnsupported
+}
+
+func (h *Header) aead(password []byte) (cipher.AEAD, error) {
+	key, err := h.key(password)
+	if err != nil {
+		return nil, err
+	}
+	switch h.Cipher {
+	case XChaCha20Poly1305:
+		return chacha20poly1305.NewX(key)
+	case AES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	}
+	return nil, ErrUnsupported
+}
+
+// Seal encrypts the validated mnemonic with the password into a binary
+// container, opts may be nil
+func Seal(mnemonic string, lang bip39.Language, password []byte, opts *Options) ([]byte, error) {
+	entropy, err := bip39.MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = new(Options)
+	}
+	h, err := opts.header()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := h.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	header := h.marshal()
+	tag := lang.Info().Tag.String()
+	plain := append(append([]byte{byte(len(tag))}, tag...), entropy...)
+	return aead.Seal(header, h.Nonce, plain, header), nil
+}
+
+// Open decrypts a binary container and returns the validated mnemonic
+func Open(data, password []byte) (string, bip39.Language, error) {
+	h, n, err := ParseHeader(data)
+	if err != nil {
+		return "", 0, err
+	}
+	aead, err := h.aead(password)
+	if err != nil {
+		return "", 0, err
+	}
+	plain, err := aead.Open(nil, h.Nonce, data[n:], data[:n])
+	if err != nil {
+		return "", 0, ErrDecrypt
+	}
+	lang, entropy, err := splitPlain(plain)
+	if err != nil {
+		return "", 0, err
+	}
+	mnemonic, err := bip39.NewMnemonicByEntropy(entropy, lang)
+	if err != nil {
+		return "", 0, err
+	}
+	return mnemonic, lang, nil
+}
+
+// splitPlain returns the language of the tag and the entropy of the plaintext
+func splitPlain(plain []byte) (bip39.Language, []byte, error) {
+	if len(plain) == 0 || len(plain) <= int(plain[0]) {
+		return 0, nil, ErrFormat
+	}
+	tag := string(plain[1 : 1+plain[0]])
+	for _, lang := range bip39.Languages() {
+		if lang.Info().Tag.String() == tag {
+			return lang, plain[1+plain[0]:], nil
+		}
+	}
+	return 0, nil, ErrFormat
+}
+
+// Armor returns the PEM armored text of a binary container
+func Armor(data []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: data}))
+}
+
+// Dearmor returns the binary container of an armored text
+func Dearmor(text string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(text))
+	if block == nil || block.Type != pemType {
+		return nil, ErrFormat
+	}
+	return block.Bytes, nil
+}
@@ -0,0 +1,412 @@
+package seedqr
+
+import (
+	"errors"
+	"image"
+	"image/color"
+)
+
+// errDataTooLong is returned when the data doesn't fit the version
+var errDataTooLong = errors.New("seedqr: data too long for QR version")
+
+// QR code versions 1 to 3 with the error correction level L, all use a single block
+var qrVersions = [...]struct {
+	dataCodewords int
+	ecCodewords   int
+	alignment     int // center of the alignment pattern, 0 if there is none
+}{
+	1: {dataCodewords: 19, ecCodewords: 7},
+	2: {dataCodewords: 34, ecCodewords: 10, alignment: 18},
+	3: {dataCodewords: 55, ecCodewords: 15, alignment: 22},
+}
+
+// QR code segment modes
+const (
+	modeNumeric = 0b0001
+	modeByte    = 0b0100
+)
+
+// Matrix is a QR code symbol without quiet zone
+type Matrix struct {
+	size    int
+	modules []bool
+	// reserved marks function pattern modules which are not data or masked
+	reserved []bool
+}
+
+// Size returns the number of modules per side
+func (m *Matrix) Size() int {
+	return m.size
+}
+
+// Dark reports whether the module at column x and row y is dark
+func (m *Matrix) Dark(x, y int) bool {
+	return m.modules[y*m.size+x]
+}
+
+// Image renders the symbol with a 4 modules quiet zone, each module is scale pixels
+func (m *Matrix) Image(scale int) image.Image {
+	if scale < 1 {
+		scale = 1
+	}
+	const quiet = 4
+	side := (m.size + 2*quiet) * scale
+	img := image.NewGray(image.Rect(0, 0, side, side))
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			mx, my := x/scale-quiet, y/scale-quiet
+			c := color.Gray{Y: 0xff}
+			if mx >= 0 && my >= 0 && mx < m.size && my < m.size && m.Dark(mx, my) {
+				c.Y = 0
+			}
+			img.SetGray(x, y, c)
+		}
+	}
+	return img
+}
+
+// String renders the symbol as text with two characters per module
+func (m *Matrix) String() string {
+	b := make([]byte, 0, (m.size*2+1)*m.size*3)
+	for y := 0; y < m.size; y++ {
+		for x := 0; x < m.size; x++ {
+			if m.Dark(x, y) {
+				b = append(b, "██"...)
+			} else {
+				b = append(b, "  "...)
+			}
+		}
+		b = append(b, '\n')
+	}
+	return string(b)
+}
+
+type bitBuffer struct {
+	bytes []byte
+	n     int
+}
+
+func (b *bitBuffer) append(v uint32, bits int) {
+	for i := bits - 1; i >= 0; i-- {
+		if b.n%8 == 0 {
+			b.bytes = append(b.bytes, 0)
+		}
+		if v>>uint(i)&1 == 1 {
+			b.bytes[b.n/8] |= 0x80 >> uint(b.n%8)
+		}
+		b.n++
+	}
+}
+
+// encodeNumeric creates a numeric mode QR code of the version at error correction level L
+func encodeNumeric(digits string, version int) (*Matrix, error) {
+	var buf bitBuffer
+	buf.append(modeNumeric, 4)
+	buf.append(uint32(len(digits)), 10)
+	for i := 0; i < len(digits); i += 3 {
+		group := digits[i:min(i+3, len(digits))]
+		var v uint32
+		for _, c := range []byte(group) {
+			if c < '0' || c > '9' {
+				return nil, errors.New("seedqr: invalid digit")
+			}
+			v = v*10 + uint32(c-'0')
+		}
+		buf.append(v, []int{0, 4, 7, 10}[len(group)])
+	}
+	return newMatrix(&buf, version)
+}
+
+// encodeBytes creates a byte mode QR code of the version at error correction level L
+func encodeBytes(data []byte, version int) (*Matrix, error) {
+	var buf bitBuffer
+	buf.append(modeByte, 4)
+	buf.append(uint32(len(data)), 8)
+	for _, c := range data {
+		buf.append(uint32(c), 8)
+	}
+	return newMatrix(&buf, version)
+}
+
+func newMatrix(buf *bitBuffer, version int) (*Matrix, error) {
+	v := qrVersions[version]
+	capacity := v.dataCodewords * 8
+	if buf.n > capacity {
+		return nil, errDataTooLong
+	}
+	// terminator and padding
+	buf.append(0, min(4, capacity-buf.n))
+	if buf.n%8 != 0 {
+		buf.append(0, 8-buf.n%8)
+	}
+	for pad := byte(0xec); len(buf.bytes) < v.dataCodewords; pad ^= 0xec ^ 0x11 {
+		buf.bytes = append(buf.bytes, pad)
+	}
+	codewords := append(buf.bytes, reedSolomon(buf.bytes, v.ecCodewords)...)
+
+	size := 17 + 4*version
+	base := &Matrix{size: size, modules: make([]bool, size*size), reserved: make([]bool, size*size)}
+	base.drawFunctionPatterns(v.alignment)
+	base.drawCodewords(codewords)
+
+	// choose the mask with the lowest penalty
+	var best *Matrix
+	bestPenalty := -1
+	for mask := 0; mask < 8; mask++ {
+		m := &Matrix{size: size, modules: append([]bool{}, base.modules...), reserved: base.reserved}
+		m.applyMask(mask)
+		m.drawFormat(mask)
+		if p := m.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = m, p
+		}
+	}
+	return best, nil
+}
+
+func (m *Matrix) set(x, y int, dark bool) {
+	m.modules[y*m.size+x] = dark
+	m.reserved[y*m.size+x] = true
+}
+
+func (m *Matrix) drawFunctionPatterns(alignment int) {
+	// timing patterns
+	for i := 0; i < m.size; i++ {
+		m.set(6, i, i%2 == 0)
+		m.set(i, 6, i%2 == 0)
+	}
+	// finder patterns with separators
+	for _, corner := range [][2]int{{3, 3}, {m.size - 4, 3}, {3, m.size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := corner[0]+dx, corner[1]+dy
+				if x < 0 || y < 0 || x >= m.size || y >= m.size {
+					continue
+				}
+				d := max(abs(dx), abs(dy))
+				m.set(x, y, d != 2 && d != 4)
+			}
+		}
+	}
+	if alignment > 0 {
+		for dy := -2; dy <= 2; dy++ {
+			for dx := -2; dx <= 2; dx++ {
+				m.set(alignment+dx, alignment+dy, max(abs(dx), abs(dy)) != 1)
+			}
+		}
+	}
+	// reserve the format areas, they are drawn after masking
+	m.drawFormat(0)
+	// dark module
+	m.set(8, m.size-8, true)
+}
+
+// formatBits returns the BCH coded format information of level L and the mask
+func formatBits(mask int) uint32 {
+	// level L is 01
+	data := uint32(0b01<<3 | mask)
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = rem<<1 ^ (rem>>9)*0x537
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawFormat draws both copies of the format information
+func (m *Matrix) drawFormat(mask int) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return bits>>uint(i)&1 == 1 }
+	for i := 0; i <= 5; i++ {
+		m.set(8, i, bit(i))
+	}
+	m.set(8, 7, bit(6))
+	m.set(8, 8, bit(7))
+	m.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		m.set(14-i, 8, bit(i))
+	}
+	for i := 0; i < 8; i++ {
+		m.set(m.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		m.set(8, m.size-15+i, bit(i))
+	}
+	m.set(8, m.size-8, true)
+}
+
+// drawCodewords places the data in the zigzag order from the bottom right corner
+func (m *Matrix) drawCodewords(data []byte) {
+	i := 0
+	for right := m.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < m.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				upward := (right+1)&2 == 0
+				y := vert
+				if upward {
+					y = m.size - 1 - vert
+				}
+				if m.reserved[y*m.size+x] {
+					continue
+				}
+				if i < len(data)*8 {
+					m.modules[y*m.size+x] = data[i/8]>>(7-uint(i%8))&1 == 1
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (m *Matrix) applyMask(mask int) {
+	for y := 0; y < m.size; y++ {
+		for x := 0; x < m.size; x++ {
+			if m.reserved[y*m.size+x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert {
+				m.modules[y*m.size+x] = !m.modules[y*m.size+x]
+			}
+		}
+	}
+}
+
+// penalty returns the mask evaluation score of ISO/IEC 18004
+func (m *Matrix) penalty() int {
+	score := 0
+	// runs of same color and finder-like patterns in rows and columns
+	for _, horizontal := range []bool{true, false} {
+		for a := 0; a < m.size; a++ {
+			line := make([]bool, m.size)
+			for b := 0; b < m.size; b++ {
+				if horizontal {
+					line[b] = m.Dark(b, a)
+				} else {
+					line[b] = m.Dark(a, b)
+				}
+			}
+			run := 1
+			for b := 1; b <= m.size; b++ {
+				if b < m.size && line[b] == line[b-1] {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += run - 2
+				}
+				run = 1
+			}
+			for b := 0; b+11 <= m.size; b++ {
+				if matchFinderLike(line[b : b+11]) {
+					score += 40
+				}
+			}
+		}
+	}
+	// 2x2 blocks
+	dark := 0
+	for y := 0; y < m.size; y++ {
+		for x := 0; x < m.size; x++ {
+			if m.Dark(x, y) {
+				dark++
+			}
+			if x+1 < m.size && y+1 < m.size {
+				c := m.Dark(x, y)
+				if c == m.Dark(x+1, y) && c == m.Dark(x, y+1) && c == m.Dark(x+1, y+1) {
+					score += 3
+				}
+			}
+		}
+	}
+	// dark proportion
+	total := m.size * m.size
+	score += ((abs(dark*20-total*10)+total-1)/total - 1) * 10
+	return score
+}
+
+func matchFinderLike(s []bool) bool {
+	p1 := []bool{true, false, true, true, true, false, true, false, false, false, false}
+	p2 := []bool{false, false, false, false, true, false, true, true, true, false, true}
+	eq := func(p []bool) bool {
+		for i := range p {
+			if s[i] != p[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return eq(p1) || eq(p2)
+}
+
+// reedSolomon returns the error correction codewords over GF(256) with polynomial 0x11d
+func reedSolomon(data []byte, n int) []byte {
+	var exp [512]byte
+	var log [256]int
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = i
+		if x <<= 1; x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	for i := 255; i < 512; i++ {
+		exp[i] = exp[i-255]
+	}
+	mul := func(a, b byte) byte {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return exp[log[a]+log[b]]
+	}
+
+	// generator polynomial with roots α^0 to α^(n-1), highest degree first
+	gen := []byte{1}
+	for i := 0; i < n; i++ {
+		next := make([]byte, len(gen)+1)
+		for j, g := range gen {
+			next[j] ^= g
+			next[j+1] ^= mul(g, exp[i])
+		}
+		gen = next
+	}
+
+	rem := make([]byte, n)
+	for _, d := range data {
+		factor := d ^ rem[0]
+		copy(rem, rem[1:])
+		rem[n-1] = 0
+		for j := 0; j < n; j++ {
+			rem[j] ^= mul(gen[j+1], factor)
+		}
+	}
+	return rem
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,98 @@
+package seedqr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_reedSolomon(t *testing.T) {
+	// HELLO WORLD at version 1-M
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := reedSolomon(data, 10); !bytes.Equal(got, want) {
+		t.Errorf("reedSolomon() = %v, want %v", got, want)
+	}
+}
+
+func Test_formatBits(t *testing.T) {
+	tests := []struct {
+		mask int
+		want uint32
+	}{
+		{0, 0b111011111000100},
+		{3, 0b111100010011101},
+		{7, 0b110100101110110},
+	}
+	for _, tt := range tests {
+		if got := formatBits(tt.mask); got != tt.want {
+			t.Errorf("formatBits(%d) = %015b, want %015b", tt.mask, got, tt.want)
+		}
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	m, err := encodeBytes(make([]byte, 16), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Size() != 21 {
+		t.Errorf("Size() = %d, want 21", m.Size())
+	}
+	// finder pattern corners and the dark module
+	for _, p := range [][2]int{{0, 0}, {20, 0}, {0, 20}, {6, 6}, {8, 13}} {
+		if !m.Dark(p[0], p[1]) {
+			t.Errorf("Dark(%d, %d) = false, want true", p[0], p[1])
+		}
+	}
+	for _, p := range [][2]int{{7, 7}, {1, 1}, {13, 7}} {
+		if m.Dark(p[0], p[1]) {
+			t.Errorf("Dark(%d, %d) = true, want false", p[0], p[1])
+		}
+	}
+	if got := m.Image(2).Bounds().Dx(); got != (21+8)*2 {
+		t.Errorf("Image() width = %d, want %d", got, (21+8)*2)
+	}
+
+	if _, err := encodeBytes(make([]byte, 18), 1); err != errDataTooLong {
+		t.Errorf("encodeBytes() error = %v, want %v", err, errDataTooLong)
+	}
+	if _, err := encodeNumeric("12a", 1); err == nil {
+		t.Error("encodeNumeric() accepts non digits")
+	}
+}
+
+func TestMatrix_Golden(t *testing.T) {
+	tests := []struct {
+		name    string
+		encode  func() (*Matrix, error)
+		size    int
+		modules string
+	}{
+		{
+			name:    "numeric version 2",
+			encode:  func() (*Matrix, error) { return encodeNumeric("031207000702176219041506105618291711133302391421", 2) },
+			size:    25,
+			modules: "69a8722cf2541f4ad74e376a74a5dc56895f7d79873fd086721ce568178cc94f",
+		},
+		{
+			name:    "bytes version 1",
+			encode:  func() (*Matrix, error) { return encodeBytes(make([]byte, 16), 1) },
+			size:    21,
+			modules: "0cf1751446f608cfcf07fe48386862e587511a4959acfb2af27c581803d14aae",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := tt.encode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			sum := sha256.Sum256([]byte(m.String()))
+			if m.Size() != tt.size || hex.EncodeToString(sum[:]) != tt.modules {
+				t.Errorf("got size %d modules %x, want %d %v", m.Size(), sum, tt.size, tt.modules)
+			}
+		})
+	}
+}
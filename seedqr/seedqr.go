@@ -0,0 +1,151 @@
+// Package seedqr implements the SeedQR format used by SeedSigner and Sparrow.
+//
+// A Standard SeedQR is the numeric QR code of the mnemonic word indices, each
+// written as a zero-padded 4 digits decimal. A Compact SeedQR is the byte mode
+// QR code of the raw entropy. 12 words phrases use a 25x25 (version 2) Standard
+// or a 21x21 (version 1) Compact code, 24 words phrases use a 29x29 (version 3)
+// Standard or a 25x25 (version 2) Compact code, all at error correction level L.
+package seedqr
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/islishude/bip39"
+)
+
+// Error list
+var (
+	ErrWordCount    = errors.New("seedqr: only 12 and 24 words mnemonics are supported")
+	ErrInvalidData  = errors.New("seedqr: invalid SeedQR data")
+	ErrInvalidIndex = errors.New("seedqr: word index out of range")
+)
+
+// Standard returns the Standard SeedQR digits of the mnemonic
+func Standard(mnemonic string, lang bip39.Language) (string, error) {
+	entropy, err := entropyOf(mnemonic, lang)
+	if err != nil {
+		return "", err
+	}
+	indexes := wordIndexes(entropy)
+	digits := make([]byte, 0, len(indexes)*4)
+	for _, idx := range indexes {
+		digits = fmt.Appendf(digits, "%04d", idx)
+	}
+	return string(digits), nil
+}
+
+// ParseStandard returns the mnemonic of Standard SeedQR digits in the language
+func ParseStandard(digits string, lang bip39.Language) (string, error) {
+	if len(digits) != 48 && len(digits) != 96 {
+		return "", ErrInvalidData
+	}
+	indexes := make([]int, len(digits)/4)
+	for i := range indexes {
+		// every group is exactly four ASCII digits, no sign
+		var idx int
+		for _, c := range []byte(digits[i*4 : i*4+4]) {
+			if c < '0' || c > '9' {
+				return "", ErrInvalidData
+			}
+			idx = idx*10 + int(c-'0')
+		}
+		if idx > 2047 {
+			return "", ErrInvalidIndex
+		}
+		indexes[i] = idx
+	}
+	entropy, err := entropyFromIndexes(indexes)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonicByEntropy(entropy, lang)
+}
+
+// Compact returns the Compact SeedQR bytes of the mnemonic, which is its entropy
+func Compact(mnemonic string, lang bip39.Language) ([]byte, error) {
+	return entropyOf(mnemonic, lang)
+}
+
+// ParseCompact returns the mnemonic of Compact SeedQR bytes in the language
+func ParseCompact(data []byte, lang bip39.Language) (string, error) {
+	if len(data) != 16 && len(data) != 32 {
+		return "", ErrInvalidData
+	}
+	return bip39.NewMnemonicByEntropy(data, lang)
+}
+
+// StandardQR returns the Standard SeedQR symbol of the mnemonic
+func StandardQR(mnemonic string, lang bip39.Language) (*Matrix, error) {
+	digits, err := Standard(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	version := 2
+	if len(digits) == 96 {
+		version = 3
+	}
+	return encodeNumeric(digits, version)
+}
+
+// CompactQR returns the Compact SeedQR symbol of the mnemonic
+func CompactQR(mnemonic string, lang bip39.Language) (*Matrix, error) {
+	data, err := Compact(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	version := 1
+	if len(data) == 32 {
+		version = 2
+	}
+	return encodeBytes(data, version)
+}
+
+func entropyOf(mnemonic string, lang bip39.Language) ([]byte, error) {
+	entropy, err := bip39.MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	if len(entropy) != 16 && len(entropy) != 32 {
+		return nil, ErrWordCount
+	}
+	return entropy, nil
+}
+
+// wordIndexes splits the entropy and its checksum into 11 bits word indexes
+func wordIndexes(entropy []byte) []int {
+	sum := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), sum[0])
+	count := len(entropy) * 8 * 33 / 32 / 11
+
+	indexes := make([]int, count)
+	for i := range indexes {
+		for bit := i * 11; bit < i*11+11; bit++ {
+			indexes[i] = indexes[i]<<1 | int(data[bit/8]>>(7-uint(bit%8))&1)
+		}
+	}
+	return indexes
+}
+
+// entropyFromIndexes joins 11 bits word indexes and verifies the checksum
+func entropyFromIndexes(indexes []int) ([]byte, error) {
+	bits := len(indexes) * 11
+	entLen := bits * 32 / 33 / 8
+	data := make([]byte, (bits+7)/8)
+	for i, idx := range indexes {
+		for b := 0; b < 11; b++ {
+			if idx>>(10-uint(b))&1 == 1 {
+				pos := i*11 + b
+				data[pos/8] |= 0x80 >> uint(pos%8)
+			}
+		}
+	}
+	entropy := data[:entLen]
+	csBits := uint(entLen / 4)
+	sum := sha256.Sum256(entropy)
+	if data[entLen]>>(8-csBits) != sum[0]>>(8-csBits) {
+		return nil, bip39.ErrChecksumIncorrect
+	}
+	return entropy, nil
+}
@@ -0,0 +1,127 @@
+package seedqr
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/islishude/bip39"
+)
+
+func TestStandard(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		lang     bip39.Language
+		want     string
+	}{
+		{
+			name:     "24 words",
+			mnemonic: "attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire",
+			lang:     bip39.English,
+			want:     "011513251154012711900771041507421289190620080870026613431420201617920614089619290300152408010643",
+		},
+		{
+			name:     "12 words",
+			mnemonic: "check fiscal fit sword unlock rough lottery tool sting pluck bulb random",
+			lang:     bip39.English,
+			want:     "031207000702176219041506105618291711133302391421",
+		},
+		{
+			name:     "zero entropy",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			lang:     bip39.English,
+			want:     "000000000000000000000000000000000000000000000003",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Standard(tt.mnemonic, tt.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Standard() = %v, want %v", got, tt.want)
+			}
+			back, err := ParseStandard(got, tt.lang)
+			if err != nil || back != tt.mnemonic {
+				t.Errorf("ParseStandard() = %v, %v, want %v", back, err, tt.mnemonic)
+			}
+			if _, err := StandardQR(tt.mnemonic, tt.lang); err != nil {
+				t.Errorf("StandardQR() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestParseStandard_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		digits  string
+		wantErr error
+	}{
+		{"invalid length", "0000", ErrInvalidData},
+		{"not digits", "00000000000000000000000000000000000000000000000x", ErrInvalidData},
+		{"plus sign", "+12300000000000000000000000000000000000000000003", ErrInvalidData},
+		{"minus sign", "-00100000000000000000000000000000000000000000003", ErrInvalidData},
+		{"space", " 12300000000000000000000000000000000000000000003", ErrInvalidData},
+		{"non-ASCII digit", "١٢00000000000000000000000000000000000000000003", ErrInvalidData},
+		{"index out of range", "204800000000000000000000000000000000000000000003", ErrInvalidIndex},
+		{"checksum", "000000000000000000000000000000000000000000000004", bip39.ErrChecksumIncorrect},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseStandard(tt.digits, bip39.English); err != tt.wantErr {
+				t.Errorf("ParseStandard() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		want     string
+		size     int
+	}{
+		{
+			name:     "12 words",
+			mnemonic: "check fiscal fit sword unlock rough lottery tool sting pluck bulb random",
+			want:     "270af15f6e2ee178a10725d5f4d477d8",
+			size:     21,
+		},
+		{
+			name:     "24 words",
+			mnemonic: "attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire",
+			want:     "0e74b64107f94cc0ccfae6a13dcbec3662154fec67e0e00999c07892597d190a",
+			size:     25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compact(tt.mnemonic, bip39.English)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("Compact() = %x, want %v", got, tt.want)
+			}
+			back, err := ParseCompact(got, bip39.English)
+			if err != nil || back != tt.mnemonic {
+				t.Errorf("ParseCompact() = %v, %v, want %v", back, err, tt.mnemonic)
+			}
+			qr, err := CompactQR(tt.mnemonic, bip39.English)
+			if err != nil || qr.Size() != tt.size {
+				t.Errorf("CompactQR() = %v, %v, want size %d", qr, err, tt.size)
+			}
+		})
+	}
+
+	if _, err := ParseCompact(make([]byte, 20), bip39.English); err != ErrInvalidData {
+		t.Errorf("ParseCompact() error = %v, want %v", err, ErrInvalidData)
+	}
+	const words15 = "model garden swallow gravity spell custom upgrade atom practice knee cloth damp hour follow category"
+	if _, err := Compact(words15, bip39.English); err != ErrWordCount {
+		t.Errorf("Compact() error = %v, want %v", err, ErrWordCount)
+	}
+}
@@ -0,0 +1,110 @@
+package bip39
+
+import (
+	"io"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// XORSplit splits the 12, 18 or 24 words mnemonic into n mnemonics of the same
+// length as in Coldcard's SeedXOR, the xor of all parts entropy is the
+// mnemonic entropy. Every part is a valid mnemonic on its own, in the language
+// of the mnemonic. The n-1 first parts are read from rand, the crypto/rand
+// reader is used if it's nil.
+func XORSplit(mnemonic string, n int, rand io.Reader) ([]string, error) {
+	if n < 2 {
+		return nil, ErrPartCount
+	}
+	lg := mnemonicLanguage(mnemonic)
+	entropy, err := xorEntropy(mnemonic, lg)
+	if err != nil {
+		return nil, err
+	}
+	rand = randReader(rand)
+
+	parts := make([]string, n)
+	last := append([]byte{}, entropy...)
+	part := make([]byte, len(entropy))
+	for i := range n - 1 {
+		if _, err := io.ReadFull(rand, part); err != nil {
+			return nil, err
+		}
+		for j := range last {
+			last[j] ^= part[j]
+		}
+		if parts[i], err = NewMnemonicByEntropy(part, lg); err != nil {
+			return nil, err
+		}
+	}
+	if parts[n-1], err = NewMnemonicByEntropy(last, lg); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// XORCombine returns the mnemonic whose entropy is the xor of all parts
+// entropy. The parts are 12, 18 or 24 words of the same length and language,
+// the mnemonic is in that language.
+func XORCombine(parts ...string) (string, error) {
+	if len(parts) < 2 {
+		return "", ErrPartCount
+	}
+	lg := mnemonicLanguage(parts...)
+	var entropy []byte
+	for _, p := range parts {
+		ent, err := xorEntropy(strings.TrimSpace(p), lg)
+		if err != nil {
+			return "", err
+		}
+		if entropy == nil {
+			entropy = ent
+			continue
+		}
+		if len(ent) != len(entropy) {
+			return "", ErrPartLen
+		}
+		for j := range entropy {
+			entropy[j] ^= ent[j]
+		}
+	}
+	return NewMnemonicByEntropy(entropy, lg)
+}
+
+// xorEntropy returns the entropy of a 12, 18 or 24 words mnemonic
+func xorEntropy(mnemonic string, lg Language) ([]byte, error) {
+	entropy, err := MnemonicToEntropy(mnemonic, lg)
+	if err != nil {
+		return nil, err
+	}
+	if len(entropy)%8 != 0 {
+		return nil, ErrWordLen
+	}
+	return entropy, nil
+}
+
+// mnemonicLanguage returns the first language whose list has every word of
+// the mnemonics, English if there is none
+func mnemonicLanguage(mnemonics ...string) Language {
+	var words []string
+	for _, m := range mnemonics {
+		words = append(words, strings.Fields(norm.NFKD.String(m))...)
+	}
+	for _, lan := range Languages() {
+		if lan.hasWords(words) {
+			return lan
+		}
+	}
+	return English
+}
+
+// hasWords reports whether every word is in the list
+func (lan Language) hasWords(words []string) bool {
+	mapping := lan.mapping()
+	for _, word := range words {
+		if _, ok := mapping[word]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package bip39
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestXORCombine(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		want  string
+	}{
+		{
+			// https://seedxor.com
+			name: "coldcard 24 words",
+			parts: []string{
+				"romance wink lottery autumn shop bring dawn tongue range crater truth ability miss spice fitness easy legal release recall obey exchange recycle dragon room",
+				"lion misery divide hurry latin fluid camp advance illegal lab pyramid unaware eager fringe sick camera series noodle toy crowd jeans select depth lounge",
+				"vault nominee cradle silk own frown throw leg cactus recall talent worry gadget surface shy planet purpose coffee drip few seven term squeeze educate",
+			},
+			want: "silent toe meat possible chair blossom wait occur this worth option bag nurse find fish scene bench asthma bike wage world quit primary indoor",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := XORCombine(tt.parts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("XORCombine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXORSplit(t *testing.T) {
+	for _, lang := range []Language{English, Japanese, Spanish} {
+		for _, words := range []int{12, 18, 24} {
+			mnemonic, err := NewMnemonicByEntropy(bytes.Repeat([]byte{0x5a}, words/3*4), lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rand := bytes.NewReader(bytes.Repeat([]byte{0xa5, 0x3c, 0x0f}, 64))
+			parts, err := XORSplit(mnemonic, 3, rand)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(parts) != 3 {
+				t.Fatalf("XORSplit() returns %d parts", len(parts))
+			}
+			for _, p := range parts {
+				if !IsMnemonicValid(p, lang) || len(strings.Fields(p)) != words {
+					t.Errorf("XORSplit() part %q is not a valid %d words %v mnemonic", p, words, lang)
+				}
+			}
+			got, err := XORCombine(parts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != mnemonic {
+				t.Errorf("XORCombine() = %v, want %v", got, mnemonic)
+			}
+		}
+	}
+}
+
+func TestXORError(t *testing.T) {
+	m12 := "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+	m18, _ := NewMnemonicByEntropy(make([]byte, 24), English)
+	if _, err := XORSplit(m12, 1, nil); err != ErrPartCount {
+		t.Errorf("XORSplit() error = %v, want %v", err, ErrPartCount)
+	}
+	if _, err := XORSplit(m12, 2, bytes.NewReader(nil)); err == nil {
+		t.Error("XORSplit() should fail on a short reader")
+	}
+	if _, err := XORCombine(m12); err != ErrPartCount {
+		t.Errorf("XORCombine() error = %v, want %v", err, ErrPartCount)
+	}
+	if _, err := XORCombine(m12, m18); !errors.Is(err, ErrPartLen) {
+		t.Errorf("XORCombine() error = %v, want %v", err, ErrPartLen)
+	}
+	m15, _ := NewMnemonicByEntropy(make([]byte, 20), English)
+	if _, err := XORSplit(m15, 2, nil); err != ErrWordLen {
+		t.Errorf("XORSplit() error = %v, want %v", err, ErrWordLen)
+	}
+	if _, err := XORCombine(m15, m15); err != ErrWordLen {
+		t.Errorf("XORCombine() error = %v, want %v", err, ErrWordLen)
+	}
+}
@@ -0,0 +1,270 @@
+package bip39
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Shamir errors
+var (
+	ErrThreshold          = errors.New("invalid threshold or share count")
+	ErrShareCount         = errors.New("not enough shares for the threshold")
+	ErrShareHeader        = errors.New("invalid share header")
+	ErrInconsistentShares = errors.New("shares are inconsistent")
+)
+
+// Shamir39Version is the first word of a Shamir39 share
+const Shamir39Version = "shamir39-p1"
+
+// shamirMaxShares is the largest x coordinate of GF(256)
+const shamirMaxShares = 255
+
+// ShamirShare is a Shamir39 share of a mnemonic
+type ShamirShare struct {
+	Index     int // x coordinate, in [1, 255]
+	Threshold int // shares required to recover the mnemonic
+	Data      []byte
+}
+
+// ShamirSplit splits the mnemonic into n Shamir39 shares which any threshold
+// of them recover the mnemonic. The random coefficients are read from rand,
+// the crypto/rand reader is used if it's nil.
+//
+// As in the Shamir39 specification the secret is the bits of the mnemonic
+// words, checksum included, padded to whole hex digits and split by
+// secrets.js: a leading 1 bit marks the start, and every byte is shared over
+// GF(256) with the polynomial x^8+x^4+x^3+x^2+1. A share is the version word
+// "shamir39-p1", the parameter words and the share bytes as words of the same
+// list. A parameter word holds 11 bits: the first bit is set when another
+// parameter word follows, then 5 bits of the threshold and 5 bits of the
+// index, most significant bits first.
+func ShamirSplit(mnemonic string, lg Language, threshold, n int, rand io.Reader) ([]string, error) {
+	if threshold < 2 || n < threshold || n > shamirMaxShares {
+		return nil, ErrThreshold
+	}
+	if err := CheckMnemonic(mnemonic, lg); err != nil {
+		return nil, err
+	}
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	secret, err := wordsToBits(words, lg)
+	if err != nil {
+		return nil, err
+	}
+	// pad to whole hex digits then set the secrets.js start bit
+	secret.SetBit(secret, (len(words)*11+3)/4*4, 1)
+	data := secret.Bytes()
+	rand = randReader(rand)
+
+	// coefficients[i] is the polynomial of the i-th secret byte
+	coefficients := make([]byte, len(data)*(threshold-1))
+	if _, err := io.ReadFull(rand, coefficients); err != nil {
+		return nil, err
+	}
+	// the highest degree coefficient must not be zero
+	for i := range data {
+		top := coefficients[(i+1)*(threshold-1)-1 : (i+1)*(threshold-1)]
+		for top[0] == 0 {
+			if _, err := io.ReadFull(rand, top); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	shares := make([]string, n)
+	for x := 1; x <= n; x++ {
+		share := &ShamirShare{Index: x, Threshold: threshold, Data: make([]byte, len(data))}
+		for i, s := range data {
+			// Horner's method from the highest degree coefficient
+			var y byte
+			poly := coefficients[i*(threshold-1) : (i+1)*(threshold-1)]
+			for j := len(poly) - 1; j >= 0; j-- {
+				y = gfMul(y, byte(x)) ^ poly[j]
+			}
+			share.Data[i] = gfMul(y, byte(x)) ^ s
+		}
+		shares[x-1] = share.Mnemonic(lg)
+	}
+	return shares, nil
+}
+
+// wordsToBits returns the 11 bits word indexes as one number
+func wordsToBits(words []string, lg Language) (*big.Int, error) {
+	mapping := lg.mapping()
+	n := new(big.Int)
+	for _, word := range words {
+		idx, ok := mapping[word]
+		if !ok {
+			return nil, ErrShareHeader
+		}
+		n.Lsh(n, 11).Or(n, big.NewInt(idx))
+	}
+	return n, nil
+}
+
+// bitsToWords returns count words of the low bits of n
+func bitsToWords(n *big.Int, count int, lg Language) []string {
+	list := lg.list()
+	words := make([]string, count)
+	n = new(big.Int).Set(n)
+	mask := big.NewInt(2047)
+	for i := count - 1; i >= 0; i-- {
+		words[i] = list[new(big.Int).And(n, mask).Int64()]
+		n.Rsh(n, 11)
+	}
+	return words
+}
+
+// Mnemonic returns the version word, the parameter words and the data words
+// of the share
+func (s *ShamirShare) Mnemonic(lg Language) string {
+	list := lg.list()
+	var params []string
+	for m, o := s.Threshold, s.Index; m > 0 || o > 0 || len(params) == 0; m, o = m>>5, o>>5 {
+		idx := (m&31)<<5 | o&31
+		if len(params) > 0 {
+			idx |= 1 << 10
+		}
+		params = append([]string{list[idx]}, params...)
+	}
+	// the data bits are left padded to whole words
+	data := bitsToWords(new(big.Int).SetBytes(s.Data), (len(s.Data)*8+10)/11, lg)
+	words := append(append([]string{Shamir39Version}, params...), data...)
+	return strings.Join(words, lg.Info().Separator)
+}
+
+// ParseShamirShare parses a Shamir39 share written by ShamirSplit
+func ParseShamirShare(share string, lg Language) (*ShamirShare, error) {
+	words := strings.Fields(norm.NFKD.String(share))
+	if len(words) < 3 || words[0] != Shamir39Version {
+		return nil, ErrShareHeader
+	}
+	mapping := lg.mapping()
+	s := new(ShamirShare)
+	for i, word := range words[1:] {
+		idx, ok := mapping[word]
+		if !ok {
+			return nil, ErrShareHeader
+		}
+		s.Threshold = s.Threshold<<5 | int(idx>>5&31)
+		s.Index = s.Index<<5 | int(idx&31)
+		if s.Threshold > shamirMaxShares || s.Index > shamirMaxShares {
+			return nil, ErrShareHeader
+		}
+		if idx>>10 == 1 {
+			continue
+		}
+		if s.Index < 1 || s.Threshold < 2 || i+2 >= len(words) {
+			return nil, ErrShareHeader
+		}
+		data, err := wordsToBits(words[i+2:], lg)
+		if err != nil {
+			return nil, err
+		}
+		// the padding is shorter than a word
+		s.Data = data.FillBytes(make([]byte, (len(words)-i-2)*11/8))
+		return s, nil
+	}
+	return nil, ErrShareHeader
+}
+
+// ShamirCombine recovers the mnemonic from at least threshold Shamir39
+// shares. The shares must agree on the threshold and the length, extra
+// shares beyond the threshold are checked against the recovered polynomial.
+func ShamirCombine(lg Language, shares ...string) (string, error) {
+	parsed := make([]*ShamirShare, 0, len(shares))
+	seen := make(map[int]bool, len(shares))
+	for _, str := range shares {
+		s, err := ParseShamirShare(str, lg)
+		if err != nil {
+			return "", err
+		}
+		if len(parsed) > 0 && (s.Threshold != parsed[0].Threshold || len(s.Data) != len(parsed[0].Data)) {
+			return "", ErrInconsistentShares
+		}
+		if seen[s.Index] {
+			return "", ErrInconsistentShares
+		}
+		seen[s.Index] = true
+		parsed = append(parsed, s)
+	}
+	if len(parsed) == 0 || len(parsed) < parsed[0].Threshold {
+		return "", ErrShareCount
+	}
+
+	basis, extra := parsed[:parsed[0].Threshold], parsed[parsed[0].Threshold:]
+	data := interpolate(basis, 0)
+	for _, s := range extra {
+		if string(interpolate(basis, byte(s.Index))) != string(s.Data) {
+			return "", ErrInconsistentShares
+		}
+	}
+
+	// drop the secrets.js start bit and the hex digit padding
+	secret := new(big.Int).SetBytes(data)
+	if secret.Sign() == 0 {
+		return "", ErrInconsistentShares
+	}
+	bitLen := secret.BitLen() - 1
+	secret.SetBit(secret, bitLen, 0)
+	mnemonic := strings.Join(bitsToWords(secret, bitLen/11, lg), lg.Info().Separator)
+	if err := CheckMnemonic(mnemonic, lg); err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+// interpolate evaluates at x the Lagrange polynomial through the shares
+func interpolate(shares []*ShamirShare, x byte) []byte {
+	result := make([]byte, len(shares[0].Data))
+	for i, si := range shares {
+		// basis polynomial of the i-th share at x
+		var num, den byte = 1, 1
+		for j, sj := range shares {
+			if i == j {
+				continue
+			}
+			num = gfMul(num, x^byte(sj.Index))
+			den = gfMul(den, byte(si.Index)^byte(sj.Index))
+		}
+		l := gfDiv(num, den)
+		for k, y := range si.Data {
+			result[k] ^= gfMul(y, l)
+		}
+	}
+	return result
+}
+
+// GF(256) with the secrets.js polynomial x^8+x^4+x^3+x^2+1
+var gfExp, gfLog [256]byte
+
+func init() {
+	x := 1
+	for i := range 255 {
+		gfExp[i] = byte(x)
+		gfLog[x] = byte(i)
+		// multiply by the generator 2
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	gfExp[255] = gfExp[0]
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])+int(gfLog[b]))%255]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])-int(gfLog[b])+255)%255]
+}
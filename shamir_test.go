@@ -0,0 +1,150 @@
+package bip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestGF256(t *testing.T) {
+	// the secrets.js field x^8+x^4+x^3+x^2+1
+	if got := gfMul(0x80, 0x02); got != 0x1d {
+		t.Errorf("gfMul(0x80, 0x02) = %#x, want 0x1d", got)
+	}
+	if got := gfMul(0x02, 0x8e); got != 1 {
+		t.Errorf("gfMul(0x02, 0x8e) = %#x, want 0x1", got)
+	}
+	for a := 1; a < 256; a++ {
+		if gfDiv(gfMul(byte(a), 0x1d), 0x1d) != byte(a) {
+			t.Fatalf("gfDiv() is not the inverse of gfMul() for %#x", a)
+		}
+	}
+}
+
+func TestShamir(t *testing.T) {
+	tests := []struct {
+		name      string
+		mnemonic  string
+		lang      Language
+		threshold int
+		n         int
+	}{
+		{"2 of 3", "check fiscal fit sword unlock rough lottery tool sting pluck bulb random", English, 2, 3},
+		{"3 of 5", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", English, 3, 5},
+		{"japanese", "そらまめ　ほとんど　らくがき　ていか　ひみつ　てんらんかい　あいこくしん　くうふく　かいほう　こさめ　せいかつ　すめし　ろれつ　かたい　さつえい", Japanese, 2, 2},
+		{"two header words", "pieuvre revivre nuptial implorer blinder accroche chute syntaxe félin promener parcelle aimable", French, 2, 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bytes.NewReader(bytes.Repeat([]byte{0x9e, 0x37, 0x79, 0xb9}, 64))
+			shares, err := ShamirSplit(tt.mnemonic, tt.lang, tt.threshold, tt.n, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := MnemonicToEntropy(tt.mnemonic, tt.lang)
+			for i := 0; i+tt.threshold <= len(shares); i++ {
+				got, err := ShamirCombine(tt.lang, shares[i:i+tt.threshold]...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if entropy, _ := MnemonicToEntropy(got, tt.lang); !bytes.Equal(entropy, want) {
+					t.Errorf("ShamirCombine(%d...) = %v", i, got)
+				}
+			}
+			// all shares are checked against each other
+			if _, err := ShamirCombine(tt.lang, shares...); err != nil {
+				t.Errorf("ShamirCombine() error = %v", err)
+			}
+			if _, err := ShamirCombine(tt.lang, shares[:tt.threshold-1]...); err != ErrShareCount {
+				t.Errorf("ShamirCombine() error = %v, want %v", err, ErrShareCount)
+			}
+		})
+	}
+}
+
+func TestShamirShareMnemonic(t *testing.T) {
+	tests := []struct {
+		share  ShamirShare
+		header string
+	}{
+		// 0b00010_00001
+		{ShamirShare{Index: 1, Threshold: 2, Data: make([]byte, 17)}, "amused"},
+		// 0b1_00000_00001 0b0_00010_01000
+		{ShamirShare{Index: 40, Threshold: 2, Data: make([]byte, 17)}, "lens animal"},
+	}
+	for _, tt := range tests {
+		got := tt.share.Mnemonic(English)
+		if !strings.HasPrefix(got, "shamir39-p1 "+tt.header+" abandon") {
+			t.Errorf("Mnemonic() = %v, want the header %q", got, tt.header)
+		}
+		parsed, err := ParseShamirShare(got, English)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed.Index != tt.share.Index || parsed.Threshold != tt.share.Threshold {
+			t.Errorf("ParseShamirShare() = %+v", parsed)
+		}
+	}
+}
+
+func TestShamirError(t *testing.T) {
+	m := "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+	for _, params := range [][2]int{{1, 3}, {3, 2}, {2, 256}} {
+		if _, err := ShamirSplit(m, English, params[0], params[1], rand.Reader); err != ErrThreshold {
+			t.Errorf("ShamirSplit(%v) error = %v, want %v", params, err, ErrThreshold)
+		}
+	}
+
+	a, _ := ShamirSplit(m, English, 2, 3, rand.Reader)
+	b, _ := ShamirSplit(m, English, 3, 3, rand.Reader)
+	c, _ := ShamirSplit(m, English, 2, 3, rand.Reader)
+	tests := []struct {
+		name   string
+		shares []string
+		want   error
+	}{
+		{"threshold mismatch", []string{a[0], b[1]}, ErrInconsistentShares},
+		{"duplicated index", []string{a[0], a[0]}, ErrInconsistentShares},
+		{"foreign extra share", []string{a[0], a[1], c[2]}, ErrInconsistentShares},
+		{"no version word", []string{m, a[1]}, ErrShareHeader},
+		{"no data", []string{"shamir39-p1 amused", a[1]}, ErrShareHeader},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ShamirCombine(English, tt.shares...); err != tt.want {
+				t.Errorf("ShamirCombine() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestShamirSecretLayout(t *testing.T) {
+	m := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	// a zero top coefficient is read again
+	r := io.MultiReader(bytes.NewReader(make([]byte, 17)), bytes.NewReader(bytes.Repeat([]byte{0x5c}, 17)))
+	shares, err := ShamirSplit(m, English, 2, 2, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed []*ShamirShare
+	for _, share := range shares {
+		s, err := ParseShamirShare(share, English)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed = append(parsed, s)
+	}
+	for i := range parsed[0].Data {
+		if parsed[0].Data[i] == parsed[1].Data[i] {
+			t.Fatalf("share byte %d is not random", i)
+		}
+	}
+	// 132 bits of words are already whole hex digits, the start bit is the 133rd
+	want := append([]byte{0x10}, make([]byte, 15)...)
+	want = append(want, 0x03)
+	if got := interpolate(parsed, 0); !bytes.Equal(got, want) {
+		t.Errorf("secret = %x, want %x", got, want)
+	}
+}
@@ -0,0 +1,33 @@
+package bip39
+
+import "bytes"
+
+// TranslateMnemonic writes a mnemonic of the from language with the words
+// of the same indexes in the to language, so both have the same entropy.
+//
+// The BIP39 seed is NOT preserved: MnemonicToSeed hashes the sentence, not
+// the entropy, so the translated mnemonic restores a different wallet. It's
+// meant for reading a phrase to someone, the original sentence must be kept
+// to restore the wallet.
+func TranslateMnemonic(mnemonic string, from, to Language) (string, error) {
+	entropy, err := MnemonicToEntropy(mnemonic, from)
+	if err != nil {
+		return "", err
+	}
+	return fromEntropy(entropy, len(entropy)/4*3, to), nil
+}
+
+// SameEntropy reports whether two mnemonics, in the same language or not,
+// have the same entropy, which is whether one is a translation of the other
+// by TranslateMnemonic. Their seeds differ unless the sentences are equal.
+func SameEntropy(m1 string, lang1 Language, m2 string, lang2 Language) (bool, error) {
+	e1, err := MnemonicToEntropy(m1, lang1)
+	if err != nil {
+		return false, err
+	}
+	e2, err := MnemonicToEntropy(m2, lang2)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(e1, e2), nil
+}
@@ -0,0 +1,96 @@
+package bip39
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestTranslateMnemonic(t *testing.T) {
+	const english = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+	tests := []struct {
+		name     string
+		mnemonic string
+		from, to Language
+		want     string
+	}{
+		{
+			name:     "english to japanese",
+			mnemonic: english,
+			from:     English,
+			to:       Japanese,
+			want:     "おんだん　こんまけ　こんれい　ほおん　もちろん　のおづま　たいおう　みせる　ふひょう　とかす　えんぜつ　にっけい",
+		},
+		{
+			name:     "japanese to english",
+			mnemonic: "ねほりはほり　ひらがな　とさか　そつう　おうじ　あてな　きくらげ　みもと　してつ　ぱそこん　にってい　いこつ",
+			from:     Japanese,
+			to:       English,
+			want:     "rich soon pool legal busy add couch tower goose security raven anger",
+		},
+		{
+			name:     "simplified to traditional",
+			mnemonic: simplifiedMnemonic,
+			from:     ChineseSimplified,
+			to:       ChineseTraditional,
+			want:     traditionalMnemonic,
+		},
+		{
+			name:     "same language",
+			mnemonic: english,
+			from:     English,
+			to:       English,
+			want:     english,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TranslateMnemonic(tt.mnemonic, tt.from, tt.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != norm.NFD.String(tt.want) {
+				t.Errorf("TranslateMnemonic() = %q, want %q", got, tt.want)
+			}
+			for i, w := range strings.Fields(got) {
+				if tt.to.mapping()[w] != tt.from.mapping()[norm.NFKD.String(strings.Fields(tt.mnemonic)[i])] {
+					t.Errorf("TranslateMnemonic() word %d %q has another index", i, w)
+				}
+			}
+			if tt.from != tt.to && bytes.Equal(MnemonicToSeed(got, ""), MnemonicToSeed(tt.mnemonic, "")) {
+				t.Error("TranslateMnemonic() keeps the seed")
+			}
+		})
+	}
+
+	if _, err := TranslateMnemonic("check fiscal fit", English, Korean); err != ErrWordLen {
+		t.Errorf("TranslateMnemonic() error = %v, want %v", err, ErrWordLen)
+	}
+}
+
+func TestSameEntropy(t *testing.T) {
+	const english = "check fiscal fit sword unlock rough lottery tool sting pluck bulb random"
+	for lang := ChineseSimplified; lang <= Portuguese; lang++ {
+		translated, err := TranslateMnemonic(english, English, lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		same, err := SameEntropy(english, English, translated, lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !same {
+			t.Errorf("SameEntropy() = false for %v", lang)
+		}
+	}
+
+	other := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	if same, err := SameEntropy(english, English, other, English); err != nil || same {
+		t.Errorf("SameEntropy() = %v, %v", same, err)
+	}
+	if _, err := SameEntropy(english, English, other, Spanish); err == nil {
+		t.Error("SameEntropy() accepts a mnemonic of another language")
+	}
+}
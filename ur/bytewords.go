@@ -0,0 +1,107 @@
+package ur
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"strings"
+)
+
+// BytewordsStyle is the textual style of Bytewords
+type BytewordsStyle int
+
+// BytewordsStyle list
+const (
+	// Standard separates the words with spaces
+	Standard BytewordsStyle = iota
+	// URI separates the words with dashes
+	URI
+	// Minimal keeps the first and last letter of each word without separator
+	Minimal
+)
+
+// Error list
+var (
+	ErrInvalidWord     = errors.New("ur: invalid byteword")
+	ErrInvalidChecksum = errors.New("ur: invalid checksum")
+)
+
+const bytewords = "able acid also apex aqua arch atom aunt away axis back bald barn belt beta bias blue body brag brew bulb buzz calm cash cats chef city claw code cola cook cost crux curl cusp cyan dark data days deli dice diet door down draw drop drum dull duty each easy echo edge epic even exam exit eyes fact fair fern figs film fish fizz flap flew flux foxy free frog fuel fund gala game gear gems gift girl glow good gray grim guru gush gyro half hang hard hawk heat help high hill holy hope horn huts iced idea idle inch inky into iris iron item jade jazz join jolt jowl judo jugs jump junk jury keep keno kept keys kick kiln king kite kiwi knob lamb lava lazy leaf legs liar limp lion list logo loud love luau luck lung main many math maze memo menu meow mild mint miss monk nail navy need news next noon note numb obey oboe omit onyx open oval owls paid part peck play plus poem pool pose puff puma purr quad quiz race ramp real redo rich road rock roof ruby ruin runs rust safe saga scar sets silk skew slot soap solo song stub surf swan taco task taxi tent tied time tiny toil tomb toys trip tuna twin ugly undo unit urge user vast very veto vial vibe view visa void vows wall wand warm wasp wave waxy webs what when whiz wolf work yank yawn yell yoga yurt zaps zero zest zinc zone zoom"
+
+var (
+	bytewordList [256]string
+	wordIndex    = make(map[string]byte, 256)
+	minimalIndex = make(map[string]byte, 256)
+)
+
+func init() {
+	for i, w := range strings.Fields(bytewords) {
+		bytewordList[i] = w
+		wordIndex[w] = byte(i)
+		minimalIndex[w[:1]+w[3:]] = byte(i)
+	}
+}
+
+// EncodeBytewords encodes data with its CRC32 checksum as Bytewords
+func EncodeBytewords(data []byte, style BytewordsStyle) string {
+	data = binary.BigEndian.AppendUint32(append([]byte{}, data...), crc32.ChecksumIEEE(data))
+
+	var sb strings.Builder
+	for i, b := range data {
+		w := bytewordList[b]
+		switch style {
+		case Minimal:
+			sb.WriteByte(w[0])
+			sb.WriteByte(w[3])
+			continue
+		case URI:
+			if i > 0 {
+				sb.WriteByte('-')
+			}
+		default:
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteString(w)
+	}
+	return sb.String()
+}
+
+// DecodeBytewords decodes Bytewords and verifies the CRC32 checksum
+func DecodeBytewords(s string, style BytewordsStyle) ([]byte, error) {
+	s = strings.ToLower(s)
+	var words []string
+	index := wordIndex
+	switch style {
+	case Minimal:
+		if len(s)%2 != 0 {
+			return nil, ErrInvalidWord
+		}
+		for i := 0; i < len(s); i += 2 {
+			words = append(words, s[i:i+2])
+		}
+		index = minimalIndex
+	case URI:
+		words = strings.Split(s, "-")
+	default:
+		words = strings.Split(s, " ")
+	}
+
+	data := make([]byte, 0, len(words))
+	for _, w := range words {
+		b, ok := index[w]
+		if !ok {
+			return nil, ErrInvalidWord
+		}
+		data = append(data, b)
+	}
+	if len(data) < 5 {
+		return nil, ErrInvalidChecksum
+	}
+	body, sum := data[:len(data)-4], data[len(data)-4:]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(sum) {
+		return nil, ErrInvalidChecksum
+	}
+	return body, nil
+}
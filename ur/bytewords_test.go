@@ -0,0 +1,49 @@
+package ur
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBytewords(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0x80, 0xff}
+	tests := []struct {
+		style BytewordsStyle
+		want  string
+	}{
+		{Standard, "able acid also lava zoom jade need echo taxi"},
+		{URI, "able-acid-also-lava-zoom-jade-need-echo-taxi"},
+		{Minimal, "aeadaolazmjendeoti"},
+	}
+	for _, tt := range tests {
+		got := EncodeBytewords(data, tt.style)
+		if got != tt.want {
+			t.Errorf("EncodeBytewords(%d) = %q, want %q", tt.style, got, tt.want)
+		}
+		decoded, err := DecodeBytewords(tt.want, tt.style)
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Errorf("DecodeBytewords(%d) = %x, %v", tt.style, decoded, err)
+		}
+	}
+}
+
+func TestDecodeBytewordsError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		style BytewordsStyle
+		want  error
+	}{
+		{"checksum", "able acid also lava zero jade need echo taxi", Standard, ErrInvalidChecksum},
+		{"unknown word", "able acid also lava zoom jade need echo tacos", Standard, ErrInvalidWord},
+		{"odd length", "aeadaolazmjendeot", Minimal, ErrInvalidWord},
+		{"too short", "aead", Minimal, ErrInvalidChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeBytewords(tt.input, tt.style); err != tt.want {
+				t.Errorf("DecodeBytewords() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
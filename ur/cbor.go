@@ -0,0 +1,146 @@
+package ur
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// ErrInvalidCBOR is returned for malformed or unsupported CBOR data
+var ErrInvalidCBOR = errors.New("ur: invalid CBOR")
+
+// CBOR major types used by the registry types
+const (
+	cborUint  = 0
+	cborBytes = 2
+	cborText  = 3
+	cborArray = 4
+	cborMap   = 5
+	cborTag   = 6
+)
+
+func appendHead(b []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, major|27), n)
+	}
+}
+
+func appendBytes(b, data []byte) []byte {
+	return append(appendHead(b, cborBytes, uint64(len(data))), data...)
+}
+
+func appendText(b []byte, s string) []byte {
+	return append(appendHead(b, cborText, uint64(len(s))), s...)
+}
+
+// cborReader decodes the definite length subset of CBOR
+type cborReader struct {
+	data []byte
+	err  error
+}
+
+func (r *cborReader) head() (major byte, n uint64) {
+	if r.err != nil {
+		return 0, 0
+	}
+	if len(r.data) == 0 {
+		r.err = ErrInvalidCBOR
+		return 0, 0
+	}
+	major, info := r.data[0]>>5, r.data[0]&0x1f
+	r.data = r.data[1:]
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info)
+	case info <= 27:
+		size = 1 << (info - 24)
+	default:
+		r.err = ErrInvalidCBOR
+		return 0, 0
+	}
+	if len(r.data) < size {
+		r.err = ErrInvalidCBOR
+		return 0, 0
+	}
+	for _, c := range r.data[:size] {
+		n = n<<8 | uint64(c)
+	}
+	r.data = r.data[size:]
+	return major, n
+}
+
+func (r *cborReader) expect(major byte) uint64 {
+	m, n := r.head()
+	if r.err == nil && m != major {
+		r.err = ErrInvalidCBOR
+	}
+	return n
+}
+
+func (r *cborReader) uint() uint64 {
+	return r.expect(cborUint)
+}
+
+func (r *cborReader) raw(major byte) []byte {
+	n := r.expect(major)
+	if r.err != nil {
+		return nil
+	}
+	if uint64(len(r.data)) < n {
+		r.err = ErrInvalidCBOR
+		return nil
+	}
+	v := r.data[:n]
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *cborReader) bytes() []byte {
+	return append([]byte{}, r.raw(cborBytes)...)
+}
+
+func (r *cborReader) text() string {
+	return string(r.raw(cborText))
+}
+
+// skip skips over one data item
+func (r *cborReader) skip() {
+	m, n := r.head()
+	switch m {
+	case cborBytes, cborText:
+		if uint64(len(r.data)) < n {
+			r.err = ErrInvalidCBOR
+			return
+		}
+		r.data = r.data[n:]
+	case cborArray:
+		for i := uint64(0); i < n && r.err == nil; i++ {
+			r.skip()
+		}
+	case cborMap:
+		for i := uint64(0); i < 2*n && r.err == nil; i++ {
+			r.skip()
+		}
+	case cborTag:
+		r.skip()
+	case cborUint, 1, 7:
+	}
+}
+
+func (r *cborReader) done() error {
+	if r.err == nil && len(r.data) != 0 {
+		r.err = ErrInvalidCBOR
+	}
+	return r.err
+}
@@ -0,0 +1,285 @@
+package ur
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInconsistentPart is returned when a part does not belong to the message
+// being decoded
+var ErrInconsistentPart = errors.New("ur: part is inconsistent with previous parts")
+
+// minFragmentLen is the smallest fragment the encoder splits a message into
+const minFragmentLen = 10
+
+// Encoder splits a UR into an endless sequence of fountain coded parts. The
+// first SeqLen parts are the plain fragments, the following ones are mixed
+// from random fragments so a receiver that missed some parts still completes.
+type Encoder struct {
+	ur        *UR
+	checksum  uint32
+	fragments [][]byte
+	seqNum    uint32
+}
+
+// NewEncoder returns an encoder with fragments of at most maxFragmentLen bytes
+func NewEncoder(u *UR, maxFragmentLen int) (*Encoder, error) {
+	if maxFragmentLen < minFragmentLen {
+		return nil, fmt.Errorf("ur: max fragment length must be at least %d", minFragmentLen)
+	}
+	if len(u.CBOR) == 0 || !validType(u.Type) {
+		return nil, ErrInvalidType
+	}
+
+	fragmentLen := fragmentLength(len(u.CBOR), maxFragmentLen)
+	padded := make([]byte, (len(u.CBOR)+fragmentLen-1)/fragmentLen*fragmentLen)
+	copy(padded, u.CBOR)
+	e := &Encoder{ur: u, checksum: crc32.ChecksumIEEE(u.CBOR)}
+	for i := 0; i < len(padded); i += fragmentLen {
+		e.fragments = append(e.fragments, padded[i:i+fragmentLen])
+	}
+	return e, nil
+}
+
+// fragmentLength returns the nominal fragment length, the message is split
+// into the fewest fragments of at most maxLen bytes
+func fragmentLength(messageLen, maxLen int) int {
+	maxCount := max(messageLen/minFragmentLen, 1)
+	length := messageLen
+	for count := 1; count <= maxCount; count++ {
+		length = (messageLen + count - 1) / count
+		if length <= maxLen {
+			break
+		}
+	}
+	return length
+}
+
+// SeqLen returns the number of fragments
+func (e *Encoder) SeqLen() int {
+	return len(e.fragments)
+}
+
+// SinglePart reports whether the UR fits in a single part
+func (e *Encoder) SinglePart() bool {
+	return len(e.fragments) == 1
+}
+
+// NextPart returns the next part, a single part UR is always returned as is
+func (e *Encoder) NextPart() string {
+	if e.SinglePart() {
+		return e.ur.String()
+	}
+	e.seqNum++
+	indexes := chooseFragments(e.seqNum, len(e.fragments), e.checksum)
+	mixed := make([]byte, len(e.fragments[0]))
+	for _, i := range indexes {
+		xorInto(mixed, e.fragments[i])
+	}
+
+	body := appendHead(nil, cborArray, 5)
+	body = appendHead(body, cborUint, uint64(e.seqNum))
+	body = appendHead(body, cborUint, uint64(len(e.fragments)))
+	body = appendHead(body, cborUint, uint64(len(e.ur.CBOR)))
+	body = appendHead(body, cborUint, uint64(e.checksum))
+	body = appendBytes(body, mixed)
+	return fmt.Sprintf("ur:%s/%d-%d/%s", e.ur.Type, e.seqNum, len(e.fragments), EncodeBytewords(body, Minimal))
+}
+
+func xorInto(dst, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
+
+// mixedPart is a received part whose fragments are not all known yet
+type mixedPart struct {
+	indexes []int
+	data    []byte
+}
+
+// Decoder reassembles a UR from single or multiple parts received in any
+// order. Parts that are duplicated or carry no new information are ignored.
+type Decoder struct {
+	typ         string
+	seqLen      int
+	messageLen  int
+	checksum    uint32
+	fragmentLen int
+
+	fragments map[int][]byte
+	mixed     []mixedPart
+	result    *UR
+	err       error
+}
+
+// NewDecoder returns an empty decoder
+func NewDecoder() *Decoder {
+	return &Decoder{fragments: make(map[int][]byte)}
+}
+
+// Receive adds a part, it returns an error for malformed or foreign parts
+// which are otherwise ignored. Once the reassembled message fails its checksum
+// the error is returned for every part.
+func (d *Decoder) Receive(part string) error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.result != nil {
+		return nil
+	}
+	typ, components, err := split(part)
+	if err != nil {
+		return err
+	}
+	if d.typ != "" && typ != d.typ {
+		return ErrInconsistentPart
+	}
+
+	switch len(components) {
+	case 1:
+		payload, err := DecodeBytewords(components[0], Minimal)
+		if err != nil {
+			return err
+		}
+		d.result = &UR{Type: typ, CBOR: payload}
+		return nil
+	case 2:
+	default:
+		return ErrInvalidPart
+	}
+
+	seqNum, seqLen, err := parseSeq(components[0])
+	if err != nil {
+		return err
+	}
+	body, err := DecodeBytewords(components[1], Minimal)
+	if err != nil {
+		return err
+	}
+	r := &cborReader{data: body}
+	if r.expect(cborArray) != 5 && r.err == nil {
+		return ErrInvalidPart
+	}
+	pSeqNum, pSeqLen := r.uint(), r.uint()
+	messageLen, checksum := r.uint(), r.uint()
+	fragment := r.bytes()
+	if err := r.done(); err != nil {
+		return err
+	}
+	if pSeqNum != uint64(seqNum) || pSeqLen != uint64(seqLen) || len(fragment) == 0 ||
+		messageLen > uint64(seqLen)*uint64(len(fragment)) || checksum > 0xffffffff {
+		return ErrInvalidPart
+	}
+
+	if d.typ == "" {
+		d.typ, d.seqLen, d.messageLen = typ, seqLen, int(messageLen)
+		d.checksum, d.fragmentLen = uint32(checksum), len(fragment)
+	} else if seqLen != d.seqLen || int(messageLen) != d.messageLen ||
+		uint32(checksum) != d.checksum || len(fragment) != d.fragmentLen {
+		return ErrInconsistentPart
+	}
+
+	d.add(mixedPart{indexes: chooseFragments(seqNum, seqLen, d.checksum), data: fragment})
+	if len(d.fragments) == d.seqLen {
+		d.finish()
+	}
+	return d.err
+}
+
+// parseSeq parses the "seqNum-seqLen" component of a multipart UR
+func parseSeq(s string) (uint32, int, error) {
+	num, length, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, ErrInvalidPart
+	}
+	seqNum, err := strconv.ParseUint(num, 10, 32)
+	if err != nil || seqNum == 0 {
+		return 0, 0, ErrInvalidPart
+	}
+	seqLen, err := strconv.ParseUint(length, 10, 16)
+	if err != nil || seqLen == 0 {
+		return 0, 0, ErrInvalidPart
+	}
+	return uint32(seqNum), int(seqLen), nil
+}
+
+// add peels the known fragments off the part, a part left with a single
+// fragment resolves it and is propagated to the pending mixed parts
+func (d *Decoder) add(p mixedPart) {
+	queue := []mixedPart{p}
+	for len(queue) > 0 {
+		p, queue = d.reduce(queue[0]), queue[1:]
+		switch len(p.indexes) {
+		case 0:
+		case 1:
+			d.fragments[p.indexes[0]] = p.data
+			pending := d.mixed[:0]
+			for _, m := range d.mixed {
+				if slices.Contains(m.indexes, p.indexes[0]) {
+					queue = append(queue, m)
+				} else {
+					pending = append(pending, m)
+				}
+			}
+			d.mixed = pending
+		default:
+			if !slices.ContainsFunc(d.mixed, func(m mixedPart) bool { return slices.Equal(m.indexes, p.indexes) }) {
+				d.mixed = append(d.mixed, p)
+			}
+		}
+	}
+}
+
+// reduce xors the known fragments out of the part
+func (d *Decoder) reduce(p mixedPart) mixedPart {
+	indexes := make([]int, 0, len(p.indexes))
+	data := append([]byte{}, p.data...)
+	for _, i := range p.indexes {
+		if f, ok := d.fragments[i]; ok {
+			xorInto(data, f)
+		} else {
+			indexes = append(indexes, i)
+		}
+	}
+	slices.Sort(indexes)
+	return mixedPart{indexes: indexes, data: data}
+}
+
+func (d *Decoder) finish() {
+	message := make([]byte, 0, d.seqLen*d.fragmentLen)
+	for i := range d.seqLen {
+		message = append(message, d.fragments[i]...)
+	}
+	message = message[:d.messageLen]
+	if crc32.ChecksumIEEE(message) != d.checksum {
+		d.err = ErrInvalidChecksum
+		return
+	}
+	d.result = &UR{Type: d.typ, CBOR: message}
+}
+
+// Complete reports whether the UR is reassembled or failed to be
+func (d *Decoder) Complete() bool {
+	return d.result != nil || d.err != nil
+}
+
+// Progress returns the fraction of the fragments recovered so far
+func (d *Decoder) Progress() float64 {
+	if d.result != nil {
+		return 1
+	}
+	if d.seqLen == 0 {
+		return 0
+	}
+	return float64(len(d.fragments)) / float64(d.seqLen)
+}
+
+// Result returns the reassembled UR, it is nil until the decoder is complete
+func (d *Decoder) Result() (*UR, error) {
+	return d.result, d.err
+}
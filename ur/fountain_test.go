@@ -0,0 +1,197 @@
+package ur
+
+import (
+	"bytes"
+	"testing"
+)
+
+// makeMessage returns the pseudo random message of the reference test suite
+func makeMessage(n int) *UR {
+	x := newXoshiro([]byte("Wolf"))
+	msg := make([]byte, n)
+	for i := range msg {
+		msg[i] = byte(x.nextInt(0, 255))
+	}
+	return &UR{Type: "bytes", CBOR: appendBytes(nil, msg)}
+}
+
+func TestSinglePart(t *testing.T) {
+	u := makeMessage(50)
+	want := "ur:bytes/hdeymejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtgwdpfnsboxgwlbaawzuefywkdplrsrjynbvygabwjldapfcsdwkbrkch"
+	if got := u.String(); got != want {
+		t.Fatalf("String() = %s, want %s", got, want)
+	}
+	got, err := Parse(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != "bytes" || !bytes.Equal(got.CBOR, u.CBOR) {
+		t.Errorf("Parse() = %v", got)
+	}
+
+	for _, s := range []string{"uri:bytes/aeadaolazmjendeoti", "ur:by_tes/aeadaolazmjendeoti", "ur:bytes"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) should fail", s)
+		}
+	}
+}
+
+// referenceParts are the first 20 parts of the reference test suite for a 256 byte
+// message and 30 byte fragments, the parts after the 9th are mixed
+var referenceParts = []string{
+	"ur:bytes/1-9/lpadascfadaxcywenbpljkhdcahkadaemejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtdkgslpgh",
+	"ur:bytes/2-9/lpaoascfadaxcywenbpljkhdcagwdpfnsboxgwlbaawzuefywkdplrsrjynbvygabwjldapfcsgmghhkhstlrdcxaefz",
+	"ur:bytes/3-9/lpaxascfadaxcywenbpljkhdcahelbknlkuejnbadmssfhfrdpsbiegecpasvssovlgeykssjykklronvsjksopdzmol",
+	"ur:bytes/4-9/lpaaascfadaxcywenbpljkhdcasotkhemthydawydtaxneurlkosgwcekonertkbrlwmplssjtammdplolsbrdzcrtas",
+	"ur:bytes/5-9/lpahascfadaxcywenbpljkhdcatbbdfmssrkzmcwnezelennjpfzbgmuktrhtejscktelgfpdlrkfyfwdajldejokbwf",
+	"ur:bytes/6-9/lpamascfadaxcywenbpljkhdcackjlhkhybssklbwefectpfnbbectrljectpavyrolkzczcpkmwidmwoxkilghdsowp",
+	"ur:bytes/7-9/lpatascfadaxcywenbpljkhdcavszmwnjkwtclrtvaynhpahrtoxmwvwatmedibkaegdosftvandiodagdhthtrlnnhy",
+	"ur:bytes/8-9/lpayascfadaxcywenbpljkhdcadmsponkkbbhgsoltjntegepmttmoonftnbuoiyrehfrtsabzsttorodklubbuyaetk",
+	"ur:bytes/9-9/lpasascfadaxcywenbpljkhdcajskecpmdckihdyhphfotjojtfmlnwmadspaxrkytbztpbauotbgtgtaeaevtgavtny",
+	"ur:bytes/10-9/lpbkascfadaxcywenbpljkhdcahkadaemejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtwdkiplzs",
+	"ur:bytes/11-9/lpbdascfadaxcywenbpljkhdcahelbknlkuejnbadmssfhfrdpsbiegecpasvssovlgeykssjykklronvsjkvetiiapk",
+	"ur:bytes/12-9/lpbnascfadaxcywenbpljkhdcarllaluzmdmgstospeyiefmwejlwtpedamktksrvlcygmzemovovllarodtmtbnptrs",
+	"ur:bytes/13-9/lpbtascfadaxcywenbpljkhdcamtkgtpknghchchyketwsvwgwfdhpgmgtylctotzopdrpayoschcmhplffziachrfgd",
+	"ur:bytes/14-9/lpbaascfadaxcywenbpljkhdcapazewnvonnvdnsbyleynwtnsjkjndeoldydkbkdslgjkbbkortbelomueekgvstegt",
+	"ur:bytes/15-9/lpbsascfadaxcywenbpljkhdcaynmhpddpzmversbdqdfyrehnqzlugmjzmnmtwmrouohtstgsbsahpawkditkckynwt",
+	"ur:bytes/16-9/lpbeascfadaxcywenbpljkhdcawygekobamwtlihsnpalnsghenskkiynthdzotsimtojetprsttmukirlrsbtamjtpd",
+	"ur:bytes/17-9/lpbyascfadaxcywenbpljkhdcamklgftaxykpewyrtqzhydntpnytyisincxmhtbceaykolduortotiaiaiafhiaoyce",
+	"ur:bytes/18-9/lpbgascfadaxcywenbpljkhdcahkadaemejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtntwkbkwy",
+	"ur:bytes/19-9/lpbwascfadaxcywenbpljkhdcadekicpaajootjzpsdrbalpeywllbdsnbinaerkurspbncxgslgftvtsrjtksplcpeo",
+	"ur:bytes/20-9/lpbbascfadaxcywenbpljkhdcayapmrleeleaxpasfrtrdkncffwjyjzgyetdmlewtkpktgllepfrltataztksmhkbot",
+}
+
+func TestEncoder(t *testing.T) {
+	e, err := NewEncoder(makeMessage(256), 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, w := range referenceParts {
+		if got := e.NextPart(); got != w {
+			t.Errorf("NextPart() #%d = %s, want %s", i+1, got, w)
+		}
+	}
+}
+
+func TestDecoder_Reference(t *testing.T) {
+	u := makeMessage(256)
+	tests := []struct {
+		name  string
+		parts []int
+	}{
+		{"plain fragments", []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"plain fragments in reverse", []int{9, 8, 7, 6, 5, 4, 3, 2, 1}},
+		// the 5th fragment is never mixed in the first 20 parts
+		{"mixed parts after the first fragments", []int{1, 2, 3, 5, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}},
+		{"mixed parts only", []int{5, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDecoder()
+			for _, n := range tt.parts {
+				if err := d.Receive(referenceParts[n-1]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := d.Result()
+			if !d.Complete() || err != nil {
+				t.Fatalf("Result() = %v, %v", got, err)
+			}
+			if got.Type != u.Type || !bytes.Equal(got.CBOR, u.CBOR) {
+				t.Error("Result() does not match the message")
+			}
+		})
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	u := makeMessage(32767)
+	e, err := NewEncoder(u, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDecoder()
+	for n := 1; !d.Complete(); n++ {
+		part := e.NextPart()
+		// lose every other part to exercise the mixed parts
+		if n%2 == 0 {
+			continue
+		}
+		if err := d.Receive(part); err != nil {
+			t.Fatal(err)
+		}
+		if n > 1000 {
+			t.Fatal("decoder did not complete")
+		}
+	}
+	got, err := d.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != u.Type || !bytes.Equal(got.CBOR, u.CBOR) {
+		t.Error("Result() does not match the message")
+	}
+	if d.Progress() != 1 {
+		t.Errorf("Progress() = %v", d.Progress())
+	}
+}
+
+func TestDecoder_FirstSeqNum(t *testing.T) {
+	// the reference multipart test starts the encoder at part 100, all of the parts are mixed
+	u := makeMessage(32767)
+	e, err := NewEncoder(u, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.seqNum = 99
+	d := NewDecoder()
+	for !d.Complete() {
+		if err := d.Receive(e.NextPart()); err != nil {
+			t.Fatal(err)
+		}
+		if e.seqNum > 1000 {
+			t.Fatal("decoder did not complete")
+		}
+	}
+	got, err := d.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != u.Type || !bytes.Equal(got.CBOR, u.CBOR) {
+		t.Error("Result() does not match the message")
+	}
+}
+
+func TestDecoderInconsistent(t *testing.T) {
+	a, _ := NewEncoder(makeMessage(256), 30)
+	b, _ := NewEncoder(makeMessage(300), 30)
+	d := NewDecoder()
+	if err := d.Receive(a.NextPart()); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Receive(b.NextPart()); err != ErrInconsistentPart {
+		t.Errorf("Receive() error = %v, want %v", err, ErrInconsistentPart)
+	}
+	if err := d.Receive("ur:bytes/0-9/lpadascfadaxcywenbpljkhdcahkadaemejtswhhylkepmykhhtsytsnoyoyaxaedsuttydmmhhpktpmsrjtdkgslpgh"); err != ErrInvalidPart {
+		t.Errorf("Receive() error = %v, want %v", err, ErrInvalidPart)
+	}
+}
+
+func TestDecoderChecksum(t *testing.T) {
+	e, _ := NewEncoder(makeMessage(256), 30)
+	e.checksum ^= 1
+	d := NewDecoder()
+	var err error
+	for !d.Complete() {
+		err = d.Receive(e.NextPart())
+	}
+	if err != ErrInvalidChecksum {
+		t.Errorf("Receive() error = %v, want %v", err, ErrInvalidChecksum)
+	}
+	if err := d.Receive(e.NextPart()); err != ErrInvalidChecksum {
+		t.Errorf("Receive() after the failure error = %v, want %v", err, ErrInvalidChecksum)
+	}
+	if _, err := d.Result(); err != ErrInvalidChecksum {
+		t.Errorf("Result() error = %v, want %v", err, ErrInvalidChecksum)
+	}
+}
@@ -0,0 +1,189 @@
+package ur
+
+import (
+	"strings"
+	"time"
+
+	"github.com/islishude/bip39"
+)
+
+// Registry types (BCR-2020-006)
+const (
+	TypeBIP39 = "crypto-bip39"
+	TypeSeed  = "crypto-seed"
+)
+
+// tags of the dates in crypto-seed
+const (
+	tagEpoch = 1
+	tagDays  = 100
+)
+
+var langCodes = map[bip39.Language]string{
+	bip39.English:            "en",
+	bip39.ChineseSimplified:  "zh-Hans",
+	bip39.ChineseTraditional: "zh-Hant",
+	bip39.French:             "fr",
+	bip39.Italian:            "it",
+	bip39.Japanese:           "ja",
+	bip39.Korean:             "ko",
+	bip39.Spanish:            "es",
+	bip39.Czech:              "cs",
+	bip39.Portuguese:         "pt",
+}
+
+// EncodeBIP39 returns the crypto-bip39 UR of the mnemonic
+func EncodeBIP39(mnemonic string, lang bip39.Language) (*UR, error) {
+	entropy, err := bip39.MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	// re-encode to write the words as they are in the word list
+	mnemonic, err = bip39.NewMnemonicByEntropy(entropy, lang)
+	if err != nil {
+		return nil, err
+	}
+	words := strings.Fields(mnemonic)
+
+	body := appendHead(nil, cborMap, 2)
+	body = appendHead(body, cborUint, 1)
+	body = appendHead(body, cborArray, uint64(len(words)))
+	for _, w := range words {
+		body = appendText(body, w)
+	}
+	body = appendHead(body, cborUint, 2)
+	body = appendText(body, langCodes[lang])
+	return &UR{Type: TypeBIP39, CBOR: body}, nil
+}
+
+// DecodeBIP39 returns the validated mnemonic and language of a crypto-bip39
+// UR, the language defaults to English when it is not given
+func DecodeBIP39(u *UR) (string, bip39.Language, error) {
+	if u.Type != TypeBIP39 {
+		return "", 0, ErrUnexpectedUR
+	}
+	var words []string
+	code := "en"
+	r := &cborReader{data: u.CBOR}
+	for n := r.expect(cborMap); n > 0 && r.err == nil; n-- {
+		switch r.uint() {
+		case 1:
+			for m := r.expect(cborArray); m > 0 && r.err == nil; m-- {
+				words = append(words, r.text())
+			}
+		case 2:
+			code = r.text()
+		default:
+			r.skip()
+		}
+	}
+	if err := r.done(); err != nil {
+		return "", 0, err
+	}
+
+	lang, ok := languageOf(code)
+	if !ok {
+		return "", 0, ErrInvalidCBOR
+	}
+	mnemonic := strings.Join(words, lang.Info().Separator)
+	if err := bip39.CheckMnemonic(mnemonic, lang); err != nil {
+		return "", 0, err
+	}
+	return mnemonic, lang, nil
+}
+
+func languageOf(code string) (bip39.Language, bool) {
+	for lang, c := range langCodes {
+		if strings.EqualFold(c, code) {
+			return lang, true
+		}
+	}
+	return 0, false
+}
+
+// Seed is the crypto-seed registry type, in Blockchain Commons terms the
+// seed is the BIP39 entropy rather than the PBKDF2 derived binary seed
+type Seed struct {
+	Payload      []byte
+	CreationDate time.Time // optional, only the date is encoded
+	Name         string    // optional
+	Note         string    // optional
+}
+
+// SeedFromMnemonic returns the crypto-seed holding the entropy of the mnemonic
+func SeedFromMnemonic(mnemonic string, lang bip39.Language) (*Seed, error) {
+	entropy, err := bip39.MnemonicToEntropy(mnemonic, lang)
+	if err != nil {
+		return nil, err
+	}
+	return &Seed{Payload: entropy}, nil
+}
+
+// Mnemonic returns the mnemonic of the seed payload
+func (s *Seed) Mnemonic(lang bip39.Language) (string, error) {
+	return bip39.NewMnemonicByEntropy(s.Payload, lang)
+}
+
+// UR returns the crypto-seed UR of the seed
+func (s *Seed) UR() *UR {
+	var fields uint64 = 1
+	for _, set := range []bool{!s.CreationDate.IsZero(), s.Name != "", s.Note != ""} {
+		if set {
+			fields++
+		}
+	}
+	body := appendHead(nil, cborMap, fields)
+	body = appendHead(body, cborUint, 1)
+	body = appendBytes(body, s.Payload)
+	if !s.CreationDate.IsZero() {
+		body = appendHead(body, cborUint, 2)
+		body = appendHead(body, cborTag, tagDays)
+		body = appendHead(body, cborUint, uint64(s.CreationDate.Unix()/86400))
+	}
+	if s.Name != "" {
+		body = appendHead(body, cborUint, 3)
+		body = appendText(body, s.Name)
+	}
+	if s.Note != "" {
+		body = appendHead(body, cborUint, 4)
+		body = appendText(body, s.Note)
+	}
+	return &UR{Type: TypeSeed, CBOR: body}
+}
+
+// DecodeSeed parses a crypto-seed UR
+func DecodeSeed(u *UR) (*Seed, error) {
+	if u.Type != TypeSeed {
+		return nil, ErrUnexpectedUR
+	}
+	s := new(Seed)
+	r := &cborReader{data: u.CBOR}
+	for n := r.expect(cborMap); n > 0 && r.err == nil; n-- {
+		switch r.uint() {
+		case 1:
+			s.Payload = r.bytes()
+		case 2:
+			switch r.expect(cborTag) {
+			case tagDays:
+				s.CreationDate = time.Unix(int64(r.uint())*86400, 0).UTC()
+			case tagEpoch:
+				s.CreationDate = time.Unix(int64(r.uint()), 0).UTC()
+			default:
+				r.err = ErrInvalidCBOR
+			}
+		case 3:
+			s.Name = r.text()
+		case 4:
+			s.Note = r.text()
+		default:
+			r.skip()
+		}
+	}
+	if err := r.done(); err != nil {
+		return nil, err
+	}
+	if len(s.Payload) == 0 {
+		return nil, ErrInvalidCBOR
+	}
+	return s, nil
+}
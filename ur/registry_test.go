@@ -0,0 +1,128 @@
+package ur
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/islishude/bip39"
+)
+
+func TestBIP39(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		lang     bip39.Language
+	}{
+		{"english", "shield group erode awake lock sausage cash glare wave crew flame glove", bip39.English},
+		{"japanese", "そらまめ　ほとんど　らくがき　ていか　ひみつ　てんらんかい　あいこくしん　くうふく　かいほう　こさめ　せいかつ　すめし　ろれつ　かたい　さつえい", bip39.Japanese},
+		{"chinese", "氮 冠 鋒 槍 做 到 容 枯 獲 槽 弧 部", bip39.ChineseTraditional},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := EncodeBIP39(tt.mnemonic, tt.lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := Parse(strings.ToUpper(u.String()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			mnemonic, lang, err := DecodeBIP39(parsed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := bip39.MnemonicToEntropy(tt.mnemonic, tt.lang)
+			got, _ := bip39.MnemonicToEntropy(mnemonic, lang)
+			if lang != tt.lang || !bytes.Equal(got, want) {
+				t.Errorf("DecodeBIP39() = %q %v", mnemonic, lang)
+			}
+		})
+	}
+
+	if _, err := EncodeBIP39("shield group erode awake lock sausage cash glare wave crew flame flame", bip39.English); err == nil {
+		t.Error("EncodeBIP39() should reject an invalid mnemonic")
+	}
+	if _, _, err := DecodeBIP39(&UR{Type: TypeSeed}); err != ErrUnexpectedUR {
+		t.Errorf("DecodeBIP39() error = %v", err)
+	}
+}
+
+// BCR-2020-006 examples
+func TestRegistryVectors(t *testing.T) {
+	const (
+		bip39UR = "ur:crypto-bip39/oeadlkiyjkisinihjzieihiojpjlkpjoihihjpjlieihihhskthsjeihiejzjliajeiojkhskpjkhsioihieiahsjkisihiojzhsjpihiekthskoihieiajpihktihiyjzhsjnihihiojzjlkoihaoidihjtrkkndede"
+		seedUR  = "ur:crypto-seed/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos"
+		words   = "shield group erode awake lock sausage cash glare wave crew flame glove"
+	)
+	payload, _ := hex.DecodeString("c7098580125e2ab0981253468b2dbc52")
+	date := time.Date(2020, 5, 12, 0, 0, 0, 0, time.UTC) // 18394 days
+
+	u, err := EncodeBIP39(words, bip39.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.String(); got != bip39UR {
+		t.Errorf("EncodeBIP39() = %s, want %s", got, bip39UR)
+	}
+	parsed, err := Parse(bip39UR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mnemonic, lang, err := DecodeBIP39(parsed)
+	if err != nil || mnemonic != words || lang != bip39.English {
+		t.Errorf("DecodeBIP39() = %q %v %v", mnemonic, lang, err)
+	}
+
+	seed := &Seed{Payload: payload, CreationDate: date}
+	if got := seed.UR().String(); got != seedUR {
+		t.Errorf("UR() = %s, want %s", got, seedUR)
+	}
+	if parsed, err = Parse(seedUR); err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeSeed(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Payload, payload) || !got.CreationDate.Equal(date) || got.Name != "" || got.Note != "" {
+		t.Errorf("DecodeSeed() = %+v", got)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	payload, _ := hex.DecodeString("c7098580125e2ab0981253468b2dbc52")
+	seed := &Seed{
+		Payload:      payload,
+		CreationDate: time.Date(2021, 2, 24, 0, 0, 0, 0, time.UTC),
+		Name:         "Wolf",
+		Note:         "Test seed",
+	}
+	u := seed.UR()
+	// the payload of the BCR-2020-006 example
+	if s := u.String(); !strings.HasPrefix(s, "ur:crypto-seed/oxadgdstaslplabghydrpfmkbggufgludprfgmaotpie") {
+		t.Errorf("String() = %s", s)
+	}
+	got, err := DecodeSeed(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Payload, payload) || !got.CreationDate.Equal(seed.CreationDate) ||
+		got.Name != seed.Name || got.Note != seed.Note {
+		t.Errorf("DecodeSeed() = %+v", got)
+	}
+
+	mnemonic, err := got.Mnemonic(bip39.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromMnemonic, err := SeedFromMnemonic(mnemonic, bip39.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fromMnemonic.Payload, payload) {
+		t.Errorf("SeedFromMnemonic() = %x", fromMnemonic.Payload)
+	}
+}
@@ -0,0 +1,76 @@
+// Package ur implements the Blockchain Commons Uniform Resources (BCR-2020-005)
+// used by air-gapped wallets to move mnemonics over animated QR codes.
+//
+// A UR is a typed CBOR payload written as minimal Bytewords. Payloads that do
+// not fit in a single QR code are split into fountain coded parts, so the
+// receiver can reassemble the message from any large enough subset of parts.
+package ur
+
+import (
+	"errors"
+	"strings"
+)
+
+// Error list
+var (
+	ErrInvalidScheme = errors.New("ur: invalid scheme")
+	ErrInvalidType   = errors.New("ur: invalid type")
+	ErrInvalidPart   = errors.New("ur: invalid part")
+	ErrUnexpectedUR  = errors.New("ur: unexpected type")
+)
+
+// UR is a Uniform Resource of Type with the CBOR encoded payload
+type UR struct {
+	Type string
+	CBOR []byte
+}
+
+// String returns the single part form of the UR
+func (u *UR) String() string {
+	return "ur:" + u.Type + "/" + EncodeBytewords(u.CBOR, Minimal)
+}
+
+// Parse parses a single part UR, the string is case insensitive
+func Parse(s string) (*UR, error) {
+	typ, components, err := split(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(components) != 1 {
+		return nil, ErrInvalidPart
+	}
+	payload, err := DecodeBytewords(components[0], Minimal)
+	if err != nil {
+		return nil, err
+	}
+	return &UR{Type: typ, CBOR: payload}, nil
+}
+
+// split returns the type and the path components following it
+func split(s string) (string, []string, error) {
+	s = strings.ToLower(s)
+	rest, ok := strings.CutPrefix(s, "ur:")
+	if !ok {
+		return "", nil, ErrInvalidScheme
+	}
+	components := strings.Split(rest, "/")
+	if len(components) < 2 {
+		return "", nil, ErrInvalidPart
+	}
+	if !validType(components[0]) {
+		return "", nil, ErrInvalidType
+	}
+	return components[0], components[1:], nil
+}
+
+func validType(typ string) bool {
+	if typ == "" {
+		return false
+	}
+	for _, c := range typ {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package ur
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// xoshiro is the xoshiro256** generator seeded with the SHA-256 digest of
+// the seed, as used by the fountain encoder to pick fragments
+type xoshiro [4]uint64
+
+func newXoshiro(seed []byte) *xoshiro {
+	digest := sha256.Sum256(seed)
+	var x xoshiro
+	for i := range x {
+		x[i] = binary.BigEndian.Uint64(digest[i*8:])
+	}
+	return &x
+}
+
+func (x *xoshiro) next() uint64 {
+	result := bits.RotateLeft64(x[1]*5, 7) * 9
+	t := x[1] << 17
+	x[2] ^= x[0]
+	x[3] ^= x[1]
+	x[1] ^= x[2]
+	x[0] ^= x[3]
+	x[2] ^= t
+	x[3] = bits.RotateLeft64(x[3], 45)
+	return result
+}
+
+func (x *xoshiro) nextDouble() float64 {
+	return float64(x.next()) / (float64(math.MaxUint64) + 1)
+}
+
+// nextInt returns a number in [low, high]
+func (x *xoshiro) nextInt(low, high int) int {
+	return int(math.Floor(x.nextDouble()*float64(high-low+1))) + low
+}
+
+// shuffle returns a shuffled copy of items
+func (x *xoshiro) shuffle(items []int) []int {
+	remaining := append([]int{}, items...)
+	result := make([]int, 0, len(items))
+	for len(remaining) > 0 {
+		i := x.nextInt(0, len(remaining)-1)
+		result = append(result, remaining[i])
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+	return result
+}
+
+// sampler is a Vose alias method sampler
+type sampler struct {
+	probs   []float64
+	aliases []int
+}
+
+func newSampler(weights []float64) *sampler {
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	n := len(weights)
+	p := make([]float64, n)
+	for i, w := range weights {
+		p[i] = w * float64(n) / sum
+	}
+
+	var small, large []int
+	for i := n - 1; i >= 0; i-- {
+		if p[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	s := &sampler{probs: make([]float64, n), aliases: make([]int, n)}
+	for len(small) > 0 && len(large) > 0 {
+		a := small[len(small)-1]
+		small = small[:len(small)-1]
+		g := large[len(large)-1]
+		large = large[:len(large)-1]
+		s.probs[a] = p[a]
+		s.aliases[a] = g
+		p[g] += p[a] - 1
+		if p[g] < 1 {
+			small = append(small, g)
+		} else {
+			large = append(large, g)
+		}
+	}
+	for _, i := range large {
+		s.probs[i] = 1
+	}
+	for _, i := range small {
+		s.probs[i] = 1
+	}
+	return s
+}
+
+func (s *sampler) next(x *xoshiro) int {
+	r1, r2 := x.nextDouble(), x.nextDouble()
+	i := int(float64(len(s.probs)) * r1)
+	if r2 < s.probs[i] {
+		return i
+	}
+	return s.aliases[i]
+}
+
+// chooseFragments returns the fragment indexes mixed into the part seqNum
+func chooseFragments(seqNum uint32, seqLen int, checksum uint32) []int {
+	if int(seqNum) <= seqLen {
+		return []int{int(seqNum) - 1}
+	}
+	seed := binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, seqNum), checksum)
+	x := newXoshiro(seed)
+
+	weights := make([]float64, seqLen)
+	for i := range weights {
+		weights[i] = 1 / float64(i+1)
+	}
+	degree := newSampler(weights).next(x) + 1
+
+	indexes := make([]int, seqLen)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	return x.shuffle(indexes)[:degree]
+}
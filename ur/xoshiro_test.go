@@ -0,0 +1,35 @@
+package ur
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestXoshiro(t *testing.T) {
+	want := []uint64{42, 81, 85, 8, 82, 84, 76, 73, 70, 88, 2, 74, 40, 48, 77, 54, 88, 7, 5, 88}
+	x := newXoshiro([]byte("Wolf"))
+	for i, w := range want {
+		if got := x.next() % 100; got != w {
+			t.Fatalf("next() #%d = %d, want %d", i, got, w)
+		}
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	x := newXoshiro([]byte("Wolf"))
+	got := x.shuffle([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	if want := []int{6, 4, 9, 3, 10, 5, 7, 8, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("shuffle() = %v, want %v", got, want)
+	}
+}
+
+func TestSampler(t *testing.T) {
+	x := newXoshiro([]byte("Wolf"))
+	s := newSampler([]float64{1, 2, 4, 8})
+	want := []int{3, 3, 3, 3, 3, 3, 3, 0, 2, 3, 3, 3, 3, 1, 2, 2, 1, 3, 3, 2}
+	for i, w := range want {
+		if got := s.next(x); got != w {
+			t.Fatalf("next() #%d = %d, want %d", i, got, w)
+		}
+	}
+}
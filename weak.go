@@ -0,0 +1,119 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// ValidationLevel is how thoroughly CheckMnemonicLevel validates a mnemonic
+type ValidationLevel int
+
+// Validation levels
+const (
+	// ValidateChecksum checks the words and the checksum, as CheckMnemonic
+	ValidateChecksum ValidationLevel = iota
+	// ValidateStrength also rejects the published mnemonics, such as the test
+	// vectors and the development tool defaults, and the low quality entropy
+	ValidateStrength
+)
+
+var (
+	knownOnce    sync.Once
+	knownMapping map[string]bool
+)
+
+// IsMnemonicValidLevel validates a mnemonic at the level
+func IsMnemonicValidLevel(m string, lg Language, level ValidationLevel) bool {
+	return CheckMnemonicLevel(m, lg, level) == nil
+}
+
+// CheckMnemonicLevel validates a mnemonic at the level, at ValidateStrength
+// it returns ErrKnownMnemonic or ErrWeakEntropy for a valid mnemonic which
+// must not hold funds
+func CheckMnemonicLevel(m string, lg Language, level ValidationLevel) error {
+	if err := CheckMnemonic(m, lg); err != nil || level < ValidateStrength {
+		return err
+	}
+	entropy, _ := MnemonicToEntropy(m, lg)
+	return CheckEntropy(entropy)
+}
+
+// CheckEntropy returns ErrKnownMnemonic for the entropy of a published
+// mnemonic and ErrWeakEntropy for repeated bytes, a constant half, bytes or
+// word indexes in arithmetic sequence and few distinct bytes
+func CheckEntropy(entropy []byte) error {
+	knownOnce.Do(func() {
+		knownMapping = make(map[string]bool, len(knownEntropies))
+		for _, e := range knownEntropies {
+			knownMapping[e] = true
+		}
+	})
+	if knownMapping[hex.EncodeToString(entropy)] {
+		return ErrKnownMnemonic
+	}
+
+	n := len(entropy)
+	if n < 2 {
+		return fmt.Errorf("%w: too short", ErrWeakEntropy)
+	}
+	for period := 1; period <= n/2; period++ {
+		if repeats(entropy, period) {
+			return fmt.Errorf("%w: repeated bytes", ErrWeakEntropy)
+		}
+	}
+	if repeats(entropy[:n/2], 1) || repeats(entropy[n/2:], 1) {
+		return fmt.Errorf("%w: constant half", ErrWeakEntropy)
+	}
+
+	values := make([]int, n)
+	for i, b := range entropy {
+		values[i] = int(b)
+	}
+	if arithmetic(values, 256) {
+		return fmt.Errorf("%w: arithmetic sequence of bytes", ErrWeakEntropy)
+	}
+	// the words made of entropy bits only, without the checksum
+	indexes := make([]int, n*8/11)
+	for i := range indexes {
+		for bit := i * 11; bit < i*11+11; bit++ {
+			indexes[i] = indexes[i]<<1 | int(entropy[bit/8]>>(7-bit%8)&1)
+		}
+	}
+	if arithmetic(indexes, 2048) {
+		return fmt.Errorf("%w: arithmetic sequence of words", ErrWeakEntropy)
+	}
+
+	var seen [256]bool
+	distinct := 0
+	for _, b := range entropy {
+		if !seen[b] {
+			seen[b] = true
+			distinct++
+		}
+	}
+	if distinct < n/4 {
+		return fmt.Errorf("%w: %d distinct bytes", ErrWeakEntropy, distinct)
+	}
+	return nil
+}
+
+// repeats reports whether b is a repetition of its first period bytes
+func repeats(b []byte, period int) bool {
+	for i := period; i < len(b); i++ {
+		if b[i] != b[i-period] {
+			return false
+		}
+	}
+	return true
+}
+
+// arithmetic reports whether the values have a constant difference modulo m
+func arithmetic(values []int, m int) bool {
+	for i := 2; i < len(values); i++ {
+		if (values[i]-values[i-1]-values[1]+values[0])%m != 0 {
+			return false
+		}
+	}
+	return true
+}
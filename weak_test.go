@@ -0,0 +1,113 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestCheckMnemonicLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		lang     Language
+		wantErr  error
+	}{
+		{
+			name:     "trezor vector",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			lang:     English,
+			wantErr:  ErrKnownMnemonic,
+		},
+		{
+			name:     "trezor vector 24 words",
+			mnemonic: "void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold",
+			lang:     English,
+			wantErr:  ErrKnownMnemonic,
+		},
+		{
+			name:     "hardhat",
+			mnemonic: "test test test test test test test test test test test junk",
+			lang:     English,
+			wantErr:  ErrKnownMnemonic,
+		},
+		{
+			name:     "ganache",
+			mnemonic: "myth like bonus scare over problem client lizard pioneer submit female collect",
+			lang:     English,
+			wantErr:  ErrKnownMnemonic,
+		},
+		{
+			name:     "truffle",
+			mnemonic: "candy maple cake sugar pudding cream honey rich smooth crumble sweet treat",
+			lang:     English,
+			wantErr:  ErrKnownMnemonic,
+		},
+		{
+			name:     "translated fixture",
+			mnemonic: "ねほりはほり　ひらがな　とさか　そつう　おうじ　あてな　きくらげ　みもと　してつ　ぱそこん　にってい　いこつ",
+			lang:     Japanese,
+			wantErr:  ErrKnownMnemonic,
+		},
+		{
+			name:     "checksum",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+			lang:     English,
+			wantErr:  ErrChecksumIncorrect,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckMnemonicLevel(tt.mnemonic, tt.lang, ValidateStrength); err != tt.wantErr {
+				t.Errorf("CheckMnemonicLevel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got, want := IsMnemonicValidLevel(tt.mnemonic, tt.lang, ValidateChecksum), tt.wantErr != ErrChecksumIncorrect; got != want {
+				t.Errorf("IsMnemonicValidLevel(ValidateChecksum) = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckEntropy(t *testing.T) {
+	tests := []struct {
+		name    string
+		entropy string
+		wantErr error
+	}{
+		{name: "random", entropy: "3a8f0d6e5b21c9f47e82b1d0c5a39e6f"},
+		{name: "random 32 bytes", entropy: "5c1e9a0b7d3f28e64a91c07b5d2e8f3619a4c0e7b2d5f8a13c6e9b0d4f7a2c58"},
+		{name: "known", entropy: "9e885d952ad362caeb4efe34a8e91bd2", wantErr: ErrKnownMnemonic},
+		{name: "constant", entropy: "42424242424242424242424242424242", wantErr: ErrWeakEntropy},
+		{name: "period", entropy: "deadbeefdeadbeefdeadbeefdeadbeef", wantErr: ErrWeakEntropy},
+		{name: "zero half", entropy: "3a8f0d6e5b21c9f40000000000000000", wantErr: ErrWeakEntropy},
+		{name: "counter", entropy: "000102030405060708090a0b0c0d0e0f", wantErr: ErrWeakEntropy},
+		{name: "countdown", entropy: "fffdfbf9f7f5f3f1efedebe9e7e5e3e1", wantErr: ErrWeakEntropy},
+		// abandon ability able about above absent absorb abstract absurd abuse access
+		{name: "word sequence", entropy: "00000401003008014030070100240500", wantErr: ErrWeakEntropy},
+		{name: "few bytes", entropy: "01020102020101010201020201010201", wantErr: ErrWeakEntropy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entropy, err := hex.DecodeString(tt.entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := CheckEntropy(entropy); !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("CheckEntropy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKnownEntropies(t *testing.T) {
+	for _, e := range knownEntropies {
+		entropy, err := hex.DecodeString(e)
+		if err != nil {
+			t.Errorf("invalid known entropy %q: %v", e, err)
+			continue
+		}
+		if _, err := NewMnemonicByEntropy(entropy, English); err != nil {
+			t.Errorf("invalid known entropy %q: %v", e, err)
+		}
+	}
+}